@@ -4,52 +4,102 @@ package client
 import (
     "bytes"
     "context"
+    "encoding/base64"
     "encoding/json"
     "fmt"
+    "io"
     "net/http"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
-    baseURL     string
-    httpClient  *http.Client
+    baseURL      string
+    httpClient   *http.Client
+    username     string
+    password     string
+    maxRetries   int
+    retryMaxWait time.Duration
+    extraHeaders map[string]string
+
+    // tokenMu guards the access token, which is refreshed while resources run concurrently.
+    tokenMu     sync.Mutex
     accessToken string
-    username    string
-    password    string
+    tokenExpiry time.Time
+
+    // refreshMu makes sure only one goroutine logs in again when the token expires.
+    refreshMu sync.Mutex
+
+    // cache coalesces identical GET requests and keeps list responses until they are invalidated.
+    cache *responseCache
 }
 
-func NewClient(baseURL string) *Client {
-    return &Client{
-        baseURL:    baseURL,
-        httpClient: &http.Client{},
+// tokenRefreshSkew is how long before its expiry the access token is proactively refreshed.
+const tokenRefreshSkew = time.Minute
+
+func NewClient(baseURL string, opts ...Option) *Client {
+    c := &Client{
+        baseURL:      baseURL,
+        httpClient:   &http.Client{Timeout: DefaultRequestTimeout},
+        maxRetries:   DefaultMaxRetries,
+        retryMaxWait: DefaultRetryMaxWait,
+        cache:        newResponseCache(),
+    }
+    for _, opt := range opts {
+        opt(c)
     }
+    return c
 }
 
 type AuthResponse struct {
     AccessToken string `json:"accessToken"`
 }
 
+// Authenticate logs in with the given credentials. They are kept to log in again once the access token expires.
 func (c *Client) Authenticate(ctx context.Context, username, password string) error {
+    c.username = username
+    c.password = password
+    return c.login(ctx)
+}
+
+// AuthenticateWithToken authenticates with a pre-issued access token or a CLI key minted by paragon_cli_key.
+// Both are sent as bearer tokens and cannot be refreshed, so the token is checked right away by listing the
+// organizations it has access to.
+func (c *Client) AuthenticateWithToken(ctx context.Context, token string) error {
+    c.setToken(token)
+
+    if _, err := c.GetOrganizations(ctx); err != nil {
+        return fmt.Errorf("authentication failed: %w", err)
+    }
+    return nil
+}
+
+func (c *Client) login(ctx context.Context) error {
     url := fmt.Sprintf("%s/auth/login/email", c.baseURL)
     body := map[string]string{
-        "username": username,
-        "password": password,
+        "username": c.username,
+        "password": c.password,
     }
     jsonBody, _ := json.Marshal(body)
 
-    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    // Logging in has no side effects, so it's safe to retry.
+    req, err := http.NewRequestWithContext(retrySafe(ctx), "POST", url, bytes.NewBuffer(jsonBody))
     if err != nil {
         return err
     }
     req.Header.Set("Content-Type", "application/json")
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-        return fmt.Errorf("authentication failed with status code: %d", resp.StatusCode)
+        return fmt.Errorf("authentication failed: %w", newAPIError(resp))
     }
 
     var authResp AuthResponse
@@ -58,8 +108,109 @@ func (c *Client) Authenticate(ctx context.Context, username, password string) er
         return err
     }
 
-    c.accessToken = authResp.AccessToken
-    c.username = username
-    c.password = password
+    c.setToken(authResp.AccessToken)
     return nil
 }
+
+// setToken stores a new access token together with the expiry read from its "exp" claim.
+func (c *Client) setToken(token string) {
+    var claims TokenClaims
+    var expiry time.Time
+    if err := decodeTokenClaims(token, &claims); err == nil {
+        expiry = claims.Expiry()
+    }
+
+    c.tokenMu.Lock()
+    defer c.tokenMu.Unlock()
+    c.accessToken = token
+    c.tokenExpiry = expiry
+}
+
+func (c *Client) token() (string, time.Time) {
+    c.tokenMu.Lock()
+    defer c.tokenMu.Unlock()
+    return c.accessToken, c.tokenExpiry
+}
+
+// authorize marks the request as authenticated with the current access token.
+func (c *Client) authorize(req *http.Request) {
+    token, _ := c.token()
+    req.Header.Set("Authorization", "Bearer "+token)
+}
+
+func (c *Client) canRefresh() bool {
+    return c.username != "" && c.password != ""
+}
+
+// refreshToken logs in again unless another request already replaced the stale token in the meantime.
+func (c *Client) refreshToken(ctx context.Context, stale string) error {
+    c.refreshMu.Lock()
+    defer c.refreshMu.Unlock()
+
+    if current, _ := c.token(); current != stale {
+        return nil
+    }
+    return c.login(ctx)
+}
+
+// doAuthenticated sends the request, refreshing the access token of authenticated requests before it expires and
+// once more if Paragon rejects it with a 401.
+func (c *Client) doAuthenticated(req *http.Request) (*http.Response, error) {
+    if req.Header.Get("Authorization") == "" || !c.canRefresh() {
+        return c.doWithRetries(req)
+    }
+
+    ctx := req.Context()
+    token, expiry := c.token()
+    if !expiry.IsZero() && time.Now().Add(tokenRefreshSkew).After(expiry) {
+        tflog.Debug(ctx, "access token is about to expire, logging in again")
+        if err := c.refreshToken(ctx, token); err != nil {
+            return nil, err
+        }
+    }
+    c.authorize(req)
+
+    resp, err := c.doWithRetries(req)
+    if err != nil || resp.StatusCode != http.StatusUnauthorized {
+        return resp, err
+    }
+
+    // The request body can only be sent again if it can be rebuilt.
+    if req.Body != nil && req.GetBody == nil {
+        return resp, err
+    }
+
+    tflog.Debug(ctx, fmt.Sprintf("request %s %s was unauthorized, logging in again", req.Method, req.URL.Path))
+    _, _ = io.Copy(io.Discard, resp.Body)
+    resp.Body.Close()
+
+    if err := c.refreshToken(ctx, strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")); err != nil {
+        return nil, err
+    }
+    c.authorize(req)
+
+    if req.GetBody != nil {
+        body, err := req.GetBody()
+        if err != nil {
+            return nil, err
+        }
+        req.Body = body
+    }
+    return c.doWithRetries(req)
+}
+
+// decodeTokenClaims decodes the payload of a JWT into v. The signature is not verified, the token is only
+// inspected for information about the logged in user.
+func decodeTokenClaims(token string, v interface{}) error {
+    parts := strings.Split(token, ".")
+    if len(parts) != 3 {
+        return fmt.Errorf("invalid access token format")
+    }
+
+    payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+    if err != nil {
+        return err
+    }
+
+    return json.Unmarshal(payload, v)
+}
@@ -0,0 +1,178 @@
+// cache.go
+package client
+
+import (
+    "bytes"
+    "context"
+    "fmt"
+    "io"
+    "net/http"
+    "strings"
+    "sync"
+
+    "github.com/hashicorp/terraform-plugin-log/tflog"
+    "golang.org/x/sync/singleflight"
+)
+
+// Paragon has no endpoint to read a single SDK key, secret, credential or CLI key, so every resource refreshes by
+// listing the whole collection. A client lives for a single Terraform operation, which lets it answer all those
+// identical list calls with one response, until a write to the collection makes it stale.
+
+type cacheableKey struct{}
+
+// cacheable marks GET requests built with the returned context as list calls whose response can be reused.
+func cacheable(ctx context.Context) context.Context {
+    return context.WithValue(ctx, cacheableKey{}, true)
+}
+
+func isCacheable(req *http.Request) bool {
+    ok, _ := req.Context().Value(cacheableKey{}).(bool)
+    return ok
+}
+
+// relatedCollections lists writes that change collections outside of their own path, e.g. logging in with the CLI
+// creates a CLI key, creating a custom integration installs an integration and uninstalling an integration removes
+// its credentials. A write whose path contains a key
+// drops the cached lists whose path ends with one of its values.
+var relatedCollections = map[string][]string{
+    "/auth/login/cli":      {"/cli-keys"},
+    "/custom-integrations": {"/integrations", "/credentials"},
+    "/integrations/":       {"/credentials"},
+}
+
+type cachedResponse struct {
+    path       string
+    statusCode int
+    header     http.Header
+    body       []byte
+}
+
+// response builds a fresh response for one caller, as responses can be shared by several of them.
+func (r *cachedResponse) response(req *http.Request) *http.Response {
+    return &http.Response{
+        Status:        fmt.Sprintf("%d %s", r.statusCode, http.StatusText(r.statusCode)),
+        StatusCode:    r.statusCode,
+        Proto:         "HTTP/1.1",
+        ProtoMajor:    1,
+        ProtoMinor:    1,
+        Header:        r.header.Clone(),
+        Body:          io.NopCloser(bytes.NewReader(r.body)),
+        ContentLength: int64(len(r.body)),
+        Request:       req,
+    }
+}
+
+type responseCache struct {
+    group singleflight.Group
+
+    mu      sync.Mutex
+    entries map[string]*cachedResponse
+    // generation changes on every write, so a list fetched before a write is neither cached nor shared after it.
+    generation uint64
+}
+
+func newResponseCache() *responseCache {
+    return &responseCache{entries: make(map[string]*cachedResponse)}
+}
+
+func (rc *responseCache) lookup(key string) (*cachedResponse, uint64) {
+    rc.mu.Lock()
+    defer rc.mu.Unlock()
+    return rc.entries[key], rc.generation
+}
+
+func (rc *responseCache) store(key string, entry *cachedResponse, generation uint64) {
+    rc.mu.Lock()
+    defer rc.mu.Unlock()
+    if rc.generation == generation {
+        rc.entries[key] = entry
+    }
+}
+
+// invalidate drops the cached lists of the collection a write went to, i.e. the lists whose path is the path of
+// the write or one of its parents. Deleting something also drops the lists nested under it, e.g. deleting a
+// project drops the lists of its credentials and secrets.
+func (rc *responseCache) invalidate(method, path string) {
+    rc.mu.Lock()
+    defer rc.mu.Unlock()
+
+    rc.generation++
+    var suffixes []string
+    for write, collections := range relatedCollections {
+        if strings.Contains(path, write) {
+            suffixes = append(suffixes, collections...)
+        }
+    }
+    for key, entry := range rc.entries {
+        nested := method == http.MethodDelete && strings.HasPrefix(entry.path, path+"/")
+        if path == entry.path || strings.HasPrefix(path, entry.path+"/") || nested || hasAnySuffix(entry.path, suffixes) {
+            delete(rc.entries, key)
+        }
+    }
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+    for _, suffix := range suffixes {
+        if strings.HasSuffix(s, suffix) {
+            return true
+        }
+    }
+    return false
+}
+
+// do sends the request. Identical GET requests in flight at the same time share a single response, and
+// responses of list calls are kept until a write to their collection.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+    if req.Method != http.MethodGet {
+        resp, err := c.doAuthenticated(req)
+        c.cache.invalidate(req.Method, req.URL.Path)
+        return resp, err
+    }
+
+    key := req.URL.String()
+    cached, generation := c.cache.lookup(key)
+    if cached != nil && isCacheable(req) {
+        tflog.SubsystemDebug(withLogSubsystem(req.Context()), logSubsystem, "Reusing cached Paragon API response", map[string]interface{}{
+            "method": req.Method,
+            "url":    key,
+        })
+        return cached.response(req), nil
+    }
+
+    // The shared request outlives a caller that gives up, so it isn't cancelled for the callers still waiting.
+    sharedReq := req.Clone(context.WithoutCancel(req.Context()))
+    results := c.cache.group.DoChan(fmt.Sprintf("%d %s", generation, key), func() (interface{}, error) {
+        resp, err := c.doAuthenticated(sharedReq)
+        if err != nil {
+            return nil, err
+        }
+        defer resp.Body.Close()
+
+        body, err := io.ReadAll(resp.Body)
+        if err != nil {
+            return nil, err
+        }
+        return &cachedResponse{
+            path:       req.URL.Path,
+            statusCode: resp.StatusCode,
+            header:     resp.Header,
+            body:       body,
+        }, nil
+    })
+
+    var result singleflight.Result
+    select {
+    case <-req.Context().Done():
+        return nil, req.Context().Err()
+    case result = <-results:
+    }
+    if result.Err != nil {
+        return nil, result.Err
+    }
+
+    entry := result.Val.(*cachedResponse)
+    if entry.statusCode == http.StatusOK && isCacheable(req) {
+        c.cache.store(key, entry, generation)
+    }
+    return entry.response(req), nil
+}
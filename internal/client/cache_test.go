@@ -0,0 +1,252 @@
+package client_test
+
+import (
+    "context"
+    "errors"
+    "net/http"
+    "net/http/httptest"
+    "sync"
+    "sync/atomic"
+    "testing"
+    "time"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func newCachingTestClient(t *testing.T) (*fakeparagon.Server, *client.Client, *client.Project) {
+    t.Helper()
+
+    server := fakeparagon.New(t)
+    c := client.NewClient(server.URL, client.WithRetries(0, 0))
+    ctx := context.Background()
+    if err := c.Authenticate(ctx, fakeparagon.DefaultEmail, fakeparagon.DefaultPassword); err != nil {
+        t.Fatalf("authenticating: %v", err)
+    }
+    project, _, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+    return server, c, project
+}
+
+func TestListResponsesAreShared(t *testing.T) {
+    server, c, project := newCachingTestClient(t)
+    path := "/projects/" + project.ID + "/secrets"
+
+    var wg sync.WaitGroup
+    for i := 0; i < 20; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            if _, err := c.GetEnvironmentSecrets(context.Background(), project.ID); err != nil {
+                t.Errorf("listing secrets: %v", err)
+            }
+        }()
+    }
+    wg.Wait()
+
+    if _, err := c.GetEnvironmentSecrets(context.Background(), project.ID); err != nil {
+        t.Fatalf("listing secrets: %v", err)
+    }
+    if n := server.Requests(http.MethodGet, path); n != 1 {
+        t.Errorf("expected 1 request to list secrets, got %d", n)
+    }
+}
+
+func TestWritesInvalidateTheirCollection(t *testing.T) {
+    server, c, project := newCachingTestClient(t)
+    ctx := context.Background()
+    secretsPath := "/projects/" + project.ID + "/secrets"
+    keysPath := "/projects/" + project.ID + "/keys"
+
+    if _, err := c.GetEnvironmentSecrets(ctx, project.ID); err != nil {
+        t.Fatalf("listing secrets: %v", err)
+    }
+    if _, err := c.GetSDKKeys(ctx, project.ID); err != nil {
+        t.Fatalf("listing SDK keys: %v", err)
+    }
+
+    secret, err := c.CreateEnvironmentSecret(ctx, project.ID, "API_KEY", "one")
+    if err != nil {
+        t.Fatalf("creating secret: %v", err)
+    }
+    secrets, err := c.GetEnvironmentSecrets(ctx, project.ID)
+    if err != nil {
+        t.Fatalf("listing secrets: %v", err)
+    }
+    if len(secrets) != 1 || secrets[0].ID != secret.ID {
+        t.Errorf("expected the created secret to be listed, got %+v", secrets)
+    }
+    if n := server.Requests(http.MethodGet, secretsPath); n != 2 {
+        t.Errorf("expected 2 requests to list secrets, got %d", n)
+    }
+
+    // Writes to a member of the collection invalidate it as well.
+    if err := c.DeleteEnvironmentSecret(ctx, project.ID, secret.ID); err != nil {
+        t.Fatalf("deleting secret: %v", err)
+    }
+    secrets, err = c.GetEnvironmentSecrets(ctx, project.ID)
+    if err != nil {
+        t.Fatalf("listing secrets: %v", err)
+    }
+    if len(secrets) != 0 {
+        t.Errorf("expected no secrets, got %+v", secrets)
+    }
+
+    // Other collections are kept.
+    if _, err := c.GetSDKKeys(ctx, project.ID); err != nil {
+        t.Fatalf("listing SDK keys: %v", err)
+    }
+    if n := server.Requests(http.MethodGet, keysPath); n != 1 {
+        t.Errorf("expected 1 request to list SDK keys, got %d", n)
+    }
+}
+
+func TestCLILoginInvalidatesCLIKeys(t *testing.T) {
+    server, c, _ := newCachingTestClient(t)
+    ctx := context.Background()
+    organizationID := server.DefaultOrganizationID()
+
+    if _, err := c.GetCLIKeys(ctx, organizationID); err != nil {
+        t.Fatalf("listing CLI keys: %v", err)
+    }
+    if _, err := c.CreateCLIKey(ctx, "ci"); err != nil {
+        t.Fatalf("creating CLI key: %v", err)
+    }
+    keys, err := c.GetCLIKeys(ctx, organizationID)
+    if err != nil {
+        t.Fatalf("listing CLI keys: %v", err)
+    }
+    if len(keys) != 1 {
+        t.Errorf("expected the created CLI key to be listed, got %+v", keys)
+    }
+}
+
+func TestFailedListsAreNotCached(t *testing.T) {
+    server, c, project := newCachingTestClient(t)
+    path := "/projects/" + project.ID + "/secrets"
+
+    server.FailNext(http.MethodGet, path, http.StatusInternalServerError, 1, "")
+    if _, err := c.GetEnvironmentSecrets(context.Background(), project.ID); err == nil {
+        t.Fatal("expected listing secrets to fail")
+    }
+    if _, err := c.GetEnvironmentSecrets(context.Background(), project.ID); err != nil {
+        t.Fatalf("expected the list to be requested again, got %v", err)
+    }
+}
+
+func TestDeletingAnIntegrationInvalidatesCredentials(t *testing.T) {
+    _, c, project := newCachingTestClient(t)
+    ctx := context.Background()
+
+    integration, err := c.CreateIntegration(ctx, project.ID, "salesforce")
+    if err != nil {
+        t.Fatalf("creating integration: %v", err)
+    }
+    _, err = c.CreateIntegrationCredentials(ctx, project.ID, client.CreateIntegrationCredentialsRequest{
+        Name:          "salesforce",
+        Values:        client.OAuthValues{ClientID: "id", ClientSecret: "secret"},
+        Provider:      "salesforce",
+        Scheme:        "oauth_app",
+        IntegrationID: integration.ID,
+    })
+    if err != nil {
+        t.Fatalf("creating credentials: %v", err)
+    }
+    credentials, err := c.GetCredentials(ctx, project.ID)
+    if err != nil {
+        t.Fatalf("listing credentials: %v", err)
+    }
+    if len(credentials) != 1 {
+        t.Fatalf("expected the created credentials to be listed, got %+v", credentials)
+    }
+
+    if err := c.DeleteIntegration(ctx, project.ID, integration.ID); err != nil {
+        t.Fatalf("deleting integration: %v", err)
+    }
+    credentials, err = c.GetCredentials(ctx, project.ID)
+    if err != nil {
+        t.Fatalf("listing credentials: %v", err)
+    }
+    if len(credentials) != 0 {
+        t.Errorf("expected the credentials to be removed with the integration, got %+v", credentials)
+    }
+}
+
+func TestDeletingAProjectInvalidatesItsLists(t *testing.T) {
+    server, c, project := newCachingTestClient(t)
+    ctx := context.Background()
+    secretsPath := "/projects/" + project.ID + "/secrets"
+    credentialsPath := "/projects/" + project.ID + "/credentials"
+
+    if _, err := c.GetEnvironmentSecrets(ctx, project.ID); err != nil {
+        t.Fatalf("listing secrets: %v", err)
+    }
+    if _, err := c.GetCredentials(ctx, project.ID); err != nil {
+        t.Fatalf("listing credentials: %v", err)
+    }
+
+    if err := c.DeleteProject(ctx, project.ID, project.TeamID); err != nil {
+        t.Fatalf("deleting project: %v", err)
+    }
+
+    // The lists are requested again, and the project is gone.
+    if _, err := c.GetEnvironmentSecrets(ctx, project.ID); err == nil {
+        t.Errorf("expected listing the secrets of a deleted project to fail")
+    }
+    if _, err := c.GetCredentials(ctx, project.ID); err == nil {
+        t.Errorf("expected listing the credentials of a deleted project to fail")
+    }
+    if n := server.Requests(http.MethodGet, secretsPath); n != 2 {
+        t.Errorf("expected 2 requests to list secrets, got %d", n)
+    }
+    if n := server.Requests(http.MethodGet, credentialsPath); n != 2 {
+        t.Errorf("expected 2 requests to list credentials, got %d", n)
+    }
+}
+
+func TestCancelledCallerDoesNotFailSharedList(t *testing.T) {
+    var requests atomic.Int32
+    received := make(chan struct{})
+    release := make(chan struct{})
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if requests.Add(1) == 1 {
+            close(received)
+        }
+        <-release
+        w.Write([]byte(`[]`))
+    }))
+    t.Cleanup(server.Close)
+
+    c := client.NewClient(server.URL, client.WithRetries(0, 0))
+
+    // The first caller starts the request and gives up while a second caller waits for the same list.
+    ctx, cancel := context.WithCancel(context.Background())
+    first := make(chan error, 1)
+    go func() {
+        _, err := c.GetEnvironmentSecrets(ctx, "p")
+        first <- err
+    }()
+    <-received
+
+    second := make(chan error, 1)
+    go func() {
+        _, err := c.GetEnvironmentSecrets(context.Background(), "p")
+        second <- err
+    }()
+    time.Sleep(50 * time.Millisecond)
+
+    cancel()
+    if err := <-first; !errors.Is(err, context.Canceled) {
+        t.Errorf("expected the cancelled caller to give up, got %v", err)
+    }
+
+    close(release)
+    if err := <-second; err != nil {
+        t.Errorf("expected the waiting caller to get the list, got %v", err)
+    }
+    if n := requests.Load(); n != 1 {
+        t.Errorf("expected the list to be requested once, got %d requests", n)
+    }
+}
@@ -0,0 +1,59 @@
+// claims.go
+package client
+
+import (
+    "fmt"
+    "time"
+)
+
+// TokenClaims are the claims of a Paragon access token, identifying the logged in user.
+type TokenClaims struct {
+    UserID    string `json:"id"`
+    Email     string `json:"email"`
+    IssuedAt  int64  `json:"iat"`
+    ExpiresAt int64  `json:"exp"`
+}
+
+// Expiry returns when the token expires, or the zero time when it has no expiry.
+func (t *TokenClaims) Expiry() time.Time {
+    if t.ExpiresAt <= 0 {
+        return time.Time{}
+    }
+    return time.Unix(t.ExpiresAt, 0)
+}
+
+// TokenClaims returns the claims of the current access token. CLI keys are opaque and carry no claims, so the
+// logged in user is only known when authenticating with credentials or an access token.
+func (c *Client) TokenClaims() (*TokenClaims, error) {
+    token, _ := c.token()
+
+    var claims TokenClaims
+    if err := decodeTokenClaims(token, &claims); err != nil {
+        return nil, fmt.Errorf("the logged in user can only be read from a JWT access token, not from a CLI key: %w", err)
+    }
+    return &claims, nil
+}
+
+// GetUserIDFromToken returns the identifier of the logged in user.
+func (c *Client) GetUserIDFromToken() (string, error) {
+    claims, err := c.TokenClaims()
+    if err != nil {
+        return "", err
+    }
+    if claims.UserID == "" {
+        return "", fmt.Errorf("user ID not found in access token")
+    }
+    return claims.UserID, nil
+}
+
+// GetUserEmailFromToken returns the email of the logged in user.
+func (c *Client) GetUserEmailFromToken() (string, error) {
+    claims, err := c.TokenClaims()
+    if err != nil {
+        return "", err
+    }
+    if claims.Email == "" {
+        return "", fmt.Errorf("email not found in access token")
+    }
+    return claims.Email, nil
+}
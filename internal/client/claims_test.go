@@ -0,0 +1,57 @@
+package client_test
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func TestTokenClaims(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := client.NewClient(server.URL)
+    if err := c.Authenticate(context.Background(), fakeparagon.DefaultEmail, fakeparagon.DefaultPassword); err != nil {
+        t.Fatalf("authenticating: %v", err)
+    }
+
+    claims, err := c.TokenClaims()
+    if err != nil {
+        t.Fatalf("reading token claims: %v", err)
+    }
+    if claims.UserID != server.DefaultUserID() {
+        t.Errorf("expected user ID %q, got %q", server.DefaultUserID(), claims.UserID)
+    }
+    if claims.Email != fakeparagon.DefaultEmail {
+        t.Errorf("expected email %q, got %q", fakeparagon.DefaultEmail, claims.Email)
+    }
+    if expiry := claims.Expiry(); expiry.Before(time.Now()) || expiry.After(time.Now().Add(server.TokenTTL+time.Minute)) {
+        t.Errorf("unexpected token expiry %s", expiry)
+    }
+
+    email, err := c.GetUserEmailFromToken()
+    if err != nil || email != fakeparagon.DefaultEmail {
+        t.Errorf("expected email %q, got %q (%v)", fakeparagon.DefaultEmail, email, err)
+    }
+}
+
+func TestTokenClaimsOfCLIKey(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := client.NewClient(server.URL)
+    if err := c.Authenticate(context.Background(), fakeparagon.DefaultEmail, fakeparagon.DefaultPassword); err != nil {
+        t.Fatalf("authenticating: %v", err)
+    }
+    key, err := c.CreateCLIKey(context.Background(), "ci")
+    if err != nil {
+        t.Fatalf("creating CLI key: %v", err)
+    }
+
+    withKey := client.NewClient(server.URL)
+    if err := withKey.AuthenticateWithToken(context.Background(), key.Key); err != nil {
+        t.Fatalf("authenticating with CLI key: %v", err)
+    }
+    if _, err := withKey.TokenClaims(); err == nil {
+        t.Error("expected a CLI key to carry no claims")
+    }
+}
@@ -5,8 +5,6 @@ import (
     "bytes"
     "context"
     "encoding/json"
-    "encoding/base64"
-    "strings"
     "fmt"
     "net/http"
 )
@@ -26,6 +24,11 @@ type CLIKey struct {
 }
 
 func (c *Client) CreateCLIKey(ctx context.Context, keyName string) (*CLIKeyResponse, error) {
+    // Minting a CLI key is a login of its own, so it needs the user's credentials.
+    if !c.canRefresh() {
+        return nil, fmt.Errorf("creating a CLI key requires the provider to authenticate with username and password")
+    }
+
     url := fmt.Sprintf("%s/auth/login/cli", c.baseURL)
 
     reqBody := map[string]string{
@@ -41,14 +44,14 @@ func (c *Client) CreateCLIKey(ctx context.Context, keyName string) (*CLIKeyRespo
     }
     req.Header.Set("Content-Type", "application/json")
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusCreated {
-        return nil, fmt.Errorf("failed to create CLI key with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to create CLI key: %w", newAPIError(resp))
     }
 
     var cliKeyResp CLIKeyResponse
@@ -63,20 +66,20 @@ func (c *Client) CreateCLIKey(ctx context.Context, keyName string) (*CLIKeyRespo
 func (c *Client) GetCLIKeys(ctx context.Context, organizationID string) ([]CLIKey, error) {
     url := fmt.Sprintf("%s/organizations/%s/cli-keys", c.baseURL, organizationID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get CLI keys with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get CLI keys: %w", newAPIError(resp))
     }
 
     var cliKeys []CLIKey
@@ -88,31 +91,6 @@ func (c *Client) GetCLIKeys(ctx context.Context, organizationID string) ([]CLIKe
     return cliKeys, nil
 }
 
-func (c *Client) GetUserIDFromToken() (string, error) {
-    parts := strings.Split(c.accessToken, ".")
-    if len(parts) != 3 {
-        return "", fmt.Errorf("invalid access token format")
-    }
-
-    payload, err := base64.RawURLEncoding.DecodeString(parts[1])
-    if err != nil {
-        return "", err
-    }
-
-    var claims map[string]interface{}
-    err = json.Unmarshal(payload, &claims)
-    if err != nil {
-        return "", err
-    }
-
-    userID, ok := claims["id"].(string)
-    if !ok {
-        return "", fmt.Errorf("user ID not found in access token")
-    }
-
-    return userID, nil
-}
-
 func (c *Client) UpdateCLIKey(ctx context.Context, organizationID, keyID, newName string) (*CLIKey, error) {
     url := fmt.Sprintf("%s/organizations/%s/cli-keys/%s", c.baseURL, organizationID, keyID)
 
@@ -126,16 +104,16 @@ func (c *Client) UpdateCLIKey(ctx context.Context, organizationID, keyID, newNam
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to update CLI key with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to update CLI key: %w", newAPIError(resp))
     }
 
     var updatedCLIKey CLIKey
@@ -154,16 +132,16 @@ func (c *Client) DeleteCLIKey(ctx context.Context, organizationID, keyID string)
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete CLI key with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete CLI key: %w", newAPIError(resp))
     }
 
     return nil
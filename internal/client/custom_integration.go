@@ -0,0 +1,133 @@
+// custom_integration.go
+package client
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/http"
+)
+
+// Custom integration authentication types.
+const (
+    CustomIntegrationAuthOAuth  = "oauth"
+    CustomIntegrationAuthAPIKey = "api_key"
+    CustomIntegrationAuthBasic  = "basic"
+    CustomIntegrationAuthNone   = "none"
+)
+
+// CustomIntegrationOAuth configures the OAuth 2.0 authorization code flow of a custom integration.
+type CustomIntegrationOAuth struct {
+    AuthorizationURL string `json:"authorizationUrl"`
+    AccessTokenURL   string `json:"accessTokenUrl"`
+    Scopes           string `json:"scopes"` // Should be with spaces
+}
+
+// CustomIntegrationAPIKey configures the fields users fill in the Connect Portal to connect with an API key.
+type CustomIntegrationAPIKey struct {
+    Fields []CustomIntegrationField `json:"fields"`
+}
+
+type CustomIntegrationField struct {
+    Key   string `json:"key"`
+    Label string `json:"label"`
+}
+
+type CustomIntegrationRequest struct {
+    Name               string                   `json:"name"`
+    Slug               string                   `json:"slug,omitempty"`
+    IconURL            string                   `json:"iconUrl"`
+    APIBaseURL         string                   `json:"apiBaseUrl"`
+    AuthenticationType string                   `json:"authenticationType"`
+    OAuth              *CustomIntegrationOAuth  `json:"oauth"`
+    APIKey             *CustomIntegrationAPIKey `json:"apiKey"`
+}
+
+// CreateCustomIntegration creates a custom integration, which is installed in the project as an integration of
+// type "custom". The installed integration is returned.
+func (c *Client) CreateCustomIntegration(ctx context.Context, projectID string, reqBody CustomIntegrationRequest) (*Integration, error) {
+    url := fmt.Sprintf("%s/projects/%s/custom-integrations", c.baseURL, projectID)
+
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        return nil, fmt.Errorf("failed to create custom integration: %w", newAPIError(resp))
+    }
+
+    var integration Integration
+    err = json.NewDecoder(resp.Body).Decode(&integration)
+    if err != nil {
+        return nil, err
+    }
+
+    return &integration, nil
+}
+
+func (c *Client) UpdateCustomIntegration(ctx context.Context, projectID, customIntegrationID string, reqBody CustomIntegrationRequest) (*Integration, error) {
+    url := fmt.Sprintf("%s/projects/%s/custom-integrations/%s", c.baseURL, projectID, customIntegrationID)
+
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to update custom integration: %w", newAPIError(resp))
+    }
+
+    var integration Integration
+    err = json.NewDecoder(resp.Body).Decode(&integration)
+    if err != nil {
+        return nil, err
+    }
+
+    return &integration, nil
+}
+
+// DeleteCustomIntegration deletes a custom integration, uninstalling it from the project along with its
+// credentials.
+func (c *Client) DeleteCustomIntegration(ctx context.Context, projectID, customIntegrationID string) error {
+    url := fmt.Sprintf("%s/projects/%s/custom-integrations/%s", c.baseURL, projectID, customIntegrationID)
+
+    req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+    if err != nil {
+        return err
+    }
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("failed to delete custom integration: %w", newAPIError(resp))
+    }
+
+    return nil
+}
@@ -43,16 +43,16 @@ func (c *Client) CreateEnvironmentSecret(ctx context.Context, projectID, key, va
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusCreated {
-        return nil, fmt.Errorf("failed to create environment secret with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to create environment secret: %w", newAPIError(resp))
     }
 
     var secret EnvironmentSecret
@@ -67,20 +67,20 @@ func (c *Client) CreateEnvironmentSecret(ctx context.Context, projectID, key, va
 func (c *Client) GetEnvironmentSecrets(ctx context.Context, projectID string) ([]EnvironmentSecret, error) {
     url := fmt.Sprintf("%s/projects/%s/secrets", c.baseURL, projectID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get environment secrets with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get environment secrets: %w", newAPIError(resp))
     }
 
     var secrets []EnvironmentSecret
@@ -106,16 +106,16 @@ func (c *Client) UpdateEnvironmentSecret(ctx context.Context, projectID, secretI
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to update environment secret with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to update environment secret: %w", newAPIError(resp))
     }
 
     var updatedSecret EnvironmentSecret
@@ -134,16 +134,16 @@ func (c *Client) DeleteEnvironmentSecret(ctx context.Context, projectID, secretI
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete environment secret with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete environment secret: %w", newAPIError(resp))
     }
 
     return nil
@@ -0,0 +1,116 @@
+// errors.go
+package client
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "strings"
+)
+
+// Sentinel errors that an *APIError can be matched against with errors.Is.
+var (
+    ErrNotFound     = errors.New("not found")
+    ErrUnauthorized = errors.New("unauthorized")
+    ErrRateLimited  = errors.New("rate limited")
+)
+
+// notFoundCodes are Paragon error codes that mean "not found" even though they come back with another status.
+// Weirdly enough - a missing team member or invite is answered with a 403 and a body like:
+// {
+//     "message": "Unable to find team member.",
+//     "code": "13200",
+//     "status": 403,
+//     "meta": {
+//         "teamMemberId": "<member_id>"
+//     }
+// }
+var notFoundCodes = map[string]bool{
+    "13101": true, // Unable to find invite.
+    "13200": true, // Unable to find team member.
+}
+
+// maxErrorBodySize caps how much of an error response body is read.
+const maxErrorBodySize = 64 * 1024
+
+// APIError is returned whenever the Paragon API answers with an unexpected status code.
+type APIError struct {
+    StatusCode int
+    Code       string
+    Message    string
+    Meta       map[string]interface{}
+    Method     string
+    Path       string
+}
+
+func (e *APIError) Error() string {
+    msg := fmt.Sprintf("%s %s returned status code: %d", e.Method, e.Path, e.StatusCode)
+    if e.Code != "" {
+        msg += fmt.Sprintf(" (code %s)", e.Code)
+    }
+    if e.Message != "" {
+        msg += ": " + e.Message
+    }
+    return msg
+}
+
+// Is lets errors.Is match an *APIError against ErrNotFound, ErrUnauthorized and ErrRateLimited.
+func (e *APIError) Is(target error) bool {
+    switch target {
+    case ErrNotFound:
+        return e.StatusCode == http.StatusNotFound || notFoundCodes[e.Code]
+    case ErrUnauthorized:
+        return e.StatusCode == http.StatusUnauthorized
+    case ErrRateLimited:
+        return e.StatusCode == http.StatusTooManyRequests
+    }
+    return false
+}
+
+// newAPIError builds an *APIError from an unexpected response, decoding Paragon's error body when there is one.
+func newAPIError(resp *http.Response) *APIError {
+    apiErr := &APIError{
+        StatusCode: resp.StatusCode,
+    }
+    if resp.Request != nil {
+        apiErr.Method = resp.Request.Method
+        apiErr.Path = resp.Request.URL.Path
+    }
+
+    body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+    if err != nil || len(body) == 0 {
+        return apiErr
+    }
+
+    // Paragon sends the code either as a string ("13200") or as a number.
+    var errResp struct {
+        Message interface{}            `json:"message"`
+        Code    interface{}            `json:"code"`
+        Meta    map[string]interface{} `json:"meta"`
+    }
+    if err := json.Unmarshal(body, &errResp); err != nil {
+        apiErr.Message = string(body)
+        return apiErr
+    }
+
+    if errResp.Code != nil {
+        apiErr.Code = fmt.Sprint(errResp.Code)
+    }
+    switch message := errResp.Message.(type) {
+    case nil:
+    case []interface{}:
+        // Validation failures come back as a list of messages.
+        parts := make([]string, len(message))
+        for i, part := range message {
+            parts[i] = fmt.Sprint(part)
+        }
+        apiErr.Message = strings.Join(parts, "; ")
+    default:
+        apiErr.Message = fmt.Sprint(message)
+    }
+    apiErr.Meta = errResp.Meta
+
+    return apiErr
+}
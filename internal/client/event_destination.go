@@ -66,16 +66,16 @@ func (c *Client) CreateOrUpdateEventDestination(ctx context.Context, projectID,
         return nil, err
     }
     httpReq.Header.Set("Content-Type", "application/json")
-    httpReq.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(httpReq)
 
-    resp, err := c.httpClient.Do(httpReq)
+    resp, err := c.do(httpReq)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-        return nil, fmt.Errorf("failed to create/update event destination with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to create/update event destination: %w", newAPIError(resp))
     }
 
     responseBody, err := io.ReadAll(resp.Body)
@@ -99,20 +99,16 @@ func (c *Client) GetEventDestination(ctx context.Context, projectID, eventID str
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return nil, fmt.Errorf("event destination not found with status code: %d", resp.StatusCode)
-    }
-
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get event destination with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get event destination: %w", newAPIError(resp))
     }
 
     var eventDestination EventDestination
@@ -131,20 +127,16 @@ func (c *Client) DeleteEventDestination(ctx context.Context, projectID, eventID
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return nil
-    }
-
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete event destination with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete event destination: %w", newAPIError(resp))
     }
 
     return nil
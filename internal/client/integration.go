@@ -36,25 +36,29 @@ type CustomIntegration struct {
     Name               string      `json:"name"`
     AuthenticationType string      `json:"authenticationType"`
     Slug               string      `json:"slug"`
+    IconURL            string      `json:"iconUrl"`
+    APIBaseURL         string      `json:"apiBaseUrl"`
+    OAuth              *CustomIntegrationOAuth  `json:"oauth,omitempty"`
+    APIKey             *CustomIntegrationAPIKey `json:"apiKey,omitempty"`
 }
 
 func (c *Client) GetIntegrations(ctx context.Context, projectID string) ([]Integration, error) {
     url := fmt.Sprintf("%s/projects/%s/integrations", c.baseURL, projectID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get integrations with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get integrations: %w", newAPIError(resp))
     }
 
     var integrations []Integration
@@ -84,20 +88,20 @@ type Credential struct {
 func (c *Client) GetCredentials(ctx context.Context, projectID string) ([]Credential, error) {
     url := fmt.Sprintf("%s/projects/%s/credentials", c.baseURL, projectID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get credentials with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get credentials: %w", newAPIError(resp))
     }
 
     var credentials []Credential
@@ -109,26 +113,28 @@ func (c *Client) GetCredentials(ctx context.Context, projectID string) ([]Creden
     return credentials, nil
 }
 
-func (c *Client) GetUserEmailFromToken() (string, error) {
-    // Extract the user email from the access token (c.accessToken)
-    // Implement the logic to parse the JWT and retrieve the "email" field
-    // Return the email and any error encountered
-    // Example implementation:
-    // token, _ := jwt.Parse(c.accessToken, nil)
-    // claims, _ := token.Claims.(jwt.MapClaims)
-    // email, _ := claims["email"].(string)
-    // return email, nil
-
-    // Placeholder implementation
-    return "user@example.com", nil
+// Integration credential schemes.
+const (
+    CredentialSchemeOAuthApp = "oauth_app"
+    CredentialSchemeAPIKey   = "api_key"
+    CredentialSchemeBasic    = "basic"
+    CredentialSchemeCustom   = "custom"
+)
+
+// credentialEndpoints maps each credential scheme to the endpoint its credentials are written to.
+var credentialEndpoints = map[string]string{
+    CredentialSchemeOAuthApp: "oauth",
+    CredentialSchemeAPIKey:   "api-key",
+    CredentialSchemeBasic:    "basic",
+    CredentialSchemeCustom:   "custom",
 }
 
 type CreateIntegrationCredentialsRequest struct {
-    Name          string     `json:"name"`
-    Values        OAuthValues `json:"values"`
-    Provider      string     `json:"provider"`
-    Scheme        string     `json:"scheme"`
-    IntegrationID string     `json:"integrationId"`
+    Name          string      `json:"name"`
+    Values        interface{} `json:"values"` // OAuthValues, APIKeyValues, BasicValues or a map for custom credentials
+    Provider      string      `json:"provider"`
+    Scheme        string      `json:"scheme"`
+    IntegrationID string      `json:"integrationId"`
 }
 
 type OAuthValues struct {
@@ -137,8 +143,23 @@ type OAuthValues struct {
     Scopes       string   `json:"scopes"` // Should be with spaces
 }
 
+type APIKeyValues struct {
+    APIKey string `json:"apiKey"`
+}
+
+type BasicValues struct {
+    Username string `json:"username"`
+    Password string `json:"password"`
+}
+
+// CreateIntegrationCredentials creates the credentials of an integration, or replaces its existing credentials of
+// the same scheme. The request is sent to the endpoint of its scheme.
 func (c *Client) CreateIntegrationCredentials(ctx context.Context, projectID string, req CreateIntegrationCredentialsRequest) (*Credential, error) {
-    url := fmt.Sprintf("%s/projects/%s/credentials/oauth", c.baseURL, projectID)
+    endpoint, ok := credentialEndpoints[req.Scheme]
+    if !ok {
+        return nil, fmt.Errorf("unsupported credential scheme %q", req.Scheme)
+    }
+    url := fmt.Sprintf("%s/projects/%s/credentials/%s", c.baseURL, projectID, endpoint)
 
     jsonBody, err := json.Marshal(req)
     if err != nil {
@@ -150,16 +171,16 @@ func (c *Client) CreateIntegrationCredentials(ctx context.Context, projectID str
         return nil, err
     }
     httpReq.Header.Set("Content-Type", "application/json")
-    httpReq.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(httpReq)
 
-    resp, err := c.httpClient.Do(httpReq)
+    resp, err := c.do(httpReq)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to create/update integration credentials with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to create/update integration credentials: %w", newAPIError(resp))
     }
 
     var credential Credential
@@ -190,20 +211,16 @@ func (c *Client) GetDecryptedCredential(ctx context.Context, projectID, credID s
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return nil, fmt.Errorf("credential not found with status code: %d", resp.StatusCode)
-    }
-
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get decrypted credential with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get decrypted credential: %w", newAPIError(resp))
     }
 
     var credential DecryptedCredential
@@ -222,20 +239,16 @@ func (c *Client) DeleteCredentials(ctx context.Context, projectID, credentialsID
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return nil
-    }
-
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete credentials with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete credentials: %w", newAPIError(resp))
     }
 
     return nil
@@ -254,20 +267,16 @@ func (c *Client) UpdateIntegrationStatus(ctx context.Context, projectID, integra
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return nil, fmt.Errorf("status code: 404")
-    }
-
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to update integration status with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to update integration status: %w", newAPIError(resp))
     }
 
     var integration Integration
@@ -286,20 +295,51 @@ func (c *Client) GetIntegration(ctx context.Context, projectID, integrationID st
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return nil, fmt.Errorf("status code: 404")
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to get integration: %w", newAPIError(resp))
     }
 
-    if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get integration with status code: %d", resp.StatusCode)
+    var integration Integration
+    err = json.NewDecoder(resp.Body).Decode(&integration)
+    if err != nil {
+        return nil, err
+    }
+
+    return &integration, nil
+}
+
+// CreateIntegration installs an integration of the given type, e.g. "salesforce", in a project.
+func (c *Client) CreateIntegration(ctx context.Context, projectID, integrationType string) (*Integration, error) {
+    url := fmt.Sprintf("%s/projects/%s/integrations", c.baseURL, projectID)
+
+    reqBody := map[string]string{
+        "type": integrationType,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        return nil, fmt.Errorf("failed to create integration: %w", newAPIError(resp))
     }
 
     var integration Integration
@@ -310,3 +350,26 @@ func (c *Client) GetIntegration(ctx context.Context, projectID, integrationID st
 
     return &integration, nil
 }
+
+// DeleteIntegration uninstalls an integration from a project, along with its credentials.
+func (c *Client) DeleteIntegration(ctx context.Context, projectID, integrationID string) error {
+    url := fmt.Sprintf("%s/projects/%s/integrations/%s", c.baseURL, projectID, integrationID)
+
+    req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+    if err != nil {
+        return err
+    }
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("failed to delete integration: %w", newAPIError(resp))
+    }
+
+    return nil
+}
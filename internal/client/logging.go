@@ -0,0 +1,163 @@
+// logging.go
+package client
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "io"
+    "net/http"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem every Paragon request and response is logged to. Its level follows TF_LOG
+// and can be set on its own with TF_LOG_PROVIDER_PARAGON_CLIENT.
+const logSubsystem = "paragon_client"
+
+const (
+    redacted = "***"
+
+    // maxLoggedBodySize caps how much of a body ends up in the logs.
+    maxLoggedBodySize = 16 * 1024
+)
+
+// sensitiveFields are JSON keys whose values are masked wherever they appear in a body. "value" covers both
+// environment secret values and webhook header values, "values" the decrypted integration credentials.
+var sensitiveFields = map[string]bool{
+    "password":     true,
+    "accessToken":  true,
+    "privateKey":   true,
+    "clientId":     true,
+    "clientSecret": true,
+    "value":        true,
+    "values":       true,
+}
+
+// sensitiveHeaders are request headers that are never logged.
+var sensitiveHeaders = map[string]bool{
+    "Authorization": true,
+}
+
+func withLogSubsystem(ctx context.Context) context.Context {
+    return tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PARAGON_CLIENT"))
+}
+
+// send performs a single HTTP round trip, adding the configured extra headers, and logs it.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+    ctx := withLogSubsystem(req.Context())
+
+    for name, value := range c.extraHeaders {
+        if req.Header.Get(name) == "" {
+            req.Header.Set(name, value)
+        }
+    }
+
+    var reqBody []byte
+    if req.GetBody != nil {
+        if body, err := req.GetBody(); err == nil {
+            reqBody, _ = io.ReadAll(body)
+            body.Close()
+        }
+    }
+
+    // Extra headers may carry credentials (e.g. for a gateway in front of Paragon), so they are masked too.
+    headers := make(map[string]string)
+    for name := range req.Header {
+        value := req.Header.Get(name)
+        if _, extra := c.extraHeaders[name]; extra || sensitiveHeaders[name] {
+            value = redacted
+        }
+        headers[name] = value
+    }
+
+    tflog.SubsystemDebug(ctx, logSubsystem, "Sending Paragon API request", map[string]interface{}{
+        "method":  req.Method,
+        "url":     req.URL.String(),
+        "headers": headers,
+        "body":    redactBody(req.URL.Path, reqBody),
+    })
+
+    start := time.Now()
+    resp, err := c.httpClient.Do(req)
+    latency := time.Since(start)
+
+    if err != nil {
+        tflog.SubsystemDebug(ctx, logSubsystem, "Paragon API request failed", map[string]interface{}{
+            "method":  req.Method,
+            "url":     req.URL.String(),
+            "latency": latency.String(),
+            "error":   err.Error(),
+        })
+        return nil, err
+    }
+
+    // Read the body so it can be logged, and hand a fresh reader to the caller.
+    respBody, readErr := io.ReadAll(resp.Body)
+    resp.Body.Close()
+    resp.Body = io.NopCloser(bytes.NewReader(respBody))
+    if readErr != nil {
+        return nil, readErr
+    }
+
+    tflog.SubsystemDebug(ctx, logSubsystem, "Received Paragon API response", map[string]interface{}{
+        "method":      req.Method,
+        "url":         req.URL.String(),
+        "status_code": resp.StatusCode,
+        "latency":     latency.String(),
+        "body":        redactBody(req.URL.Path, respBody),
+    })
+
+    return resp, nil
+}
+
+// redactBody masks sensitive values of a body before it is logged. Bodies of the login endpoints are dropped
+// altogether, as they carry credentials and the minted CLI keys.
+func redactBody(path string, body []byte) string {
+    if len(body) == 0 {
+        return ""
+    }
+    if strings.HasPrefix(path, "/auth/") {
+        return redacted
+    }
+
+    var decoded interface{}
+    if err := json.Unmarshal(body, &decoded); err != nil {
+        return truncate(string(body))
+    }
+
+    masked, err := json.Marshal(redactValue(decoded))
+    if err != nil {
+        return redacted
+    }
+    return truncate(string(masked))
+}
+
+func redactValue(value interface{}) interface{} {
+    switch v := value.(type) {
+    case map[string]interface{}:
+        for key, nested := range v {
+            if sensitiveFields[key] {
+                v[key] = redacted
+                continue
+            }
+            v[key] = redactValue(nested)
+        }
+        return v
+    case []interface{}:
+        for i, nested := range v {
+            v[i] = redactValue(nested)
+        }
+        return v
+    }
+    return value
+}
+
+func truncate(body string) string {
+    if len(body) <= maxLoggedBodySize {
+        return body
+    }
+    return body[:maxLoggedBodySize] + "...(truncated)"
+}
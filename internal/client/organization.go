@@ -31,16 +31,16 @@ func (c *Client) GetOrganizations(ctx context.Context) ([]Organization, error) {
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get organizations with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get organizations: %w", newAPIError(resp))
     }
 
     var organizations []Organization
@@ -53,16 +53,16 @@ func (c *Client) CreateProject(ctx context.Context, organizationID, projectName
         return nil, nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusCreated {
-        return nil, nil, fmt.Errorf("failed to create project with status code: %d", resp.StatusCode)
+        return nil, nil, fmt.Errorf("failed to create project: %w", newAPIError(resp))
     }
 
     var createProjectResp CreateProjectResponse
@@ -113,6 +113,41 @@ func (c *Client) CreateProject(ctx context.Context, organizationID, projectName
     return connectProject, automateProject, nil
 }
 
+// CreateProjectInTeam creates a Connect project in an existing team.
+func (c *Client) CreateProjectInTeam(ctx context.Context, teamID, projectName string) (*Project, error) {
+    url := fmt.Sprintf("%s/projects?teamId=%s", c.baseURL, teamID)
+
+    reqBody := UpdateProjectTitleRequest{
+        Title: projectName,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        return nil, fmt.Errorf("failed to create project: %w", newAPIError(resp))
+    }
+
+    var project Project
+    err = json.NewDecoder(resp.Body).Decode(&project)
+    if err != nil {
+        return nil, err
+    }
+
+    return &project, nil
+}
+
 func (c *Client) GetProjects(ctx context.Context, teamID string) ([]Project, error) {
     url := fmt.Sprintf("%s/projects?teamId=%s", c.baseURL, teamID)
 
@@ -120,16 +155,16 @@ func (c *Client) GetProjects(ctx context.Context, teamID string) ([]Project, err
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get projects for team_id %s with status code: %d", teamID, resp.StatusCode)
+        return nil, fmt.Errorf("failed to get projects for team_id %s: %w", teamID, newAPIError(resp))
     }
 
     var projects []Project
@@ -148,16 +183,16 @@ func (c *Client) GetProjectByID(ctx context.Context, projectID, teamID string) (
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get project with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get project: %w", newAPIError(resp))
     }
 
     var project Project
@@ -186,16 +221,16 @@ func (c *Client) UpdateProjectTitle(ctx context.Context, projectID, teamID, newT
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to update project title with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to update project title: %w", newAPIError(resp))
     }
 
     var updatedProject Project
@@ -214,9 +249,9 @@ func (c *Client) DeleteProject(ctx context.Context, projectID, teamID string) er
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
@@ -224,7 +259,7 @@ func (c *Client) DeleteProject(ctx context.Context, projectID, teamID string) er
 
     tflog.Debug(ctx, fmt.Sprintf("delete response: %d", resp.StatusCode))
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete project with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete project: %w", newAPIError(resp))
     }
 
     return nil
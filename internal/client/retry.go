@@ -0,0 +1,143 @@
+// retry.go
+package client
+
+import (
+    "context"
+    "fmt"
+    "io"
+    "math/rand"
+    "net/http"
+    "strconv"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+    DefaultMaxRetries   = 3
+    DefaultRetryMaxWait = 30 * time.Second
+
+    // retryMinWait is the backoff before the first retry, doubled on every following attempt.
+    retryMinWait = 1 * time.Second
+)
+
+// Option configures optional behaviour of a Client.
+type Option func(*Client)
+
+// WithRetries sets how many times a failed request is retried and the longest the client waits between two attempts.
+func WithRetries(maxRetries int, maxWait time.Duration) Option {
+    return func(c *Client) {
+        c.maxRetries = maxRetries
+        c.retryMaxWait = maxWait
+    }
+}
+
+type retrySafeKey struct{}
+
+// retrySafe marks requests built with the returned context as safe to send again even though their method is
+// not idempotent (e.g. logging in).
+func retrySafe(ctx context.Context) context.Context {
+    return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// isIdempotent reports whether a request can be repeated after a server error or a dropped connection.
+// Every PATCH the client sends sets absolute values, so repeating one leaves the same result.
+func isIdempotent(req *http.Request) bool {
+    switch req.Method {
+    case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodPatch:
+        return true
+    }
+    safe, _ := req.Context().Value(retrySafeKey{}).(bool)
+    return safe
+}
+
+// shouldRetry decides whether a request is sent again. A rate-limited request was never processed, so it is
+// always repeated; server errors and transport failures only for idempotent requests.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+    if err != nil {
+        return req.Context().Err() == nil && isIdempotent(req)
+    }
+
+    switch resp.StatusCode {
+    case http.StatusTooManyRequests:
+        return true
+    case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+        return isIdempotent(req)
+    }
+    return false
+}
+
+// retryDelay returns how long to wait before the given retry attempt, honouring a Retry-After header when present.
+func (c *Client) retryDelay(attempt int, resp *http.Response) time.Duration {
+    if resp != nil {
+        if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+            return min(retryAfter, c.retryMaxWait)
+        }
+    }
+
+    backoff := retryMinWait << attempt
+    if backoff <= 0 || backoff > c.retryMaxWait {
+        backoff = c.retryMaxWait
+    }
+
+    // Equal jitter: wait at least half of the backoff so concurrent resources don't retry in lockstep.
+    half := backoff / 2
+    return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter understands both forms of the Retry-After header - delay seconds and an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+    if value == "" {
+        return 0, false
+    }
+    if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+        return time.Duration(seconds) * time.Second, true
+    }
+    if date, err := http.ParseTime(value); err == nil {
+        return max(time.Until(date), 0), true
+    }
+    return 0, false
+}
+
+// doWithRetries sends the request, retrying on rate limits and transient failures according to the client's retry policy.
+func (c *Client) doWithRetries(req *http.Request) (*http.Response, error) {
+    ctx := req.Context()
+
+    for attempt := 0; ; attempt++ {
+        resp, err := c.send(req)
+
+        if attempt >= c.maxRetries || !shouldRetry(req, resp, err) {
+            return resp, err
+        }
+
+        // A request with a body can only be replayed if the body can be rebuilt.
+        if req.Body != nil && req.GetBody == nil {
+            return resp, err
+        }
+
+        delay := c.retryDelay(attempt, resp)
+        if err != nil {
+            tflog.Debug(ctx, fmt.Sprintf("request %s %s failed, retrying in %s: %v", req.Method, req.URL.Path, delay, err))
+        } else {
+            tflog.Debug(ctx, fmt.Sprintf("request %s %s returned status code %d, retrying in %s", req.Method, req.URL.Path, resp.StatusCode, delay))
+            _, _ = io.Copy(io.Discard, resp.Body)
+            resp.Body.Close()
+        }
+
+        timer := time.NewTimer(delay)
+        select {
+        case <-ctx.Done():
+            timer.Stop()
+            return nil, ctx.Err()
+        case <-timer.C:
+        }
+
+        if req.GetBody != nil {
+            body, err := req.GetBody()
+            if err != nil {
+                return nil, err
+            }
+            req.Body = body
+        }
+    }
+}
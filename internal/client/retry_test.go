@@ -0,0 +1,254 @@
+package client
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "io"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+func TestIsIdempotent(t *testing.T) {
+    tests := []struct {
+        method string
+        ctx    context.Context
+        want   bool
+    }{
+        {http.MethodGet, context.Background(), true},
+        {http.MethodPut, context.Background(), true},
+        {http.MethodPatch, context.Background(), true},
+        {http.MethodDelete, context.Background(), true},
+        {http.MethodPost, context.Background(), false},
+        // Requests marked retry safe can be repeated whatever their method.
+        {http.MethodPost, retrySafe(context.Background()), true},
+    }
+
+    for _, tt := range tests {
+        req := httptest.NewRequest(tt.method, "/", nil).WithContext(tt.ctx)
+        if got := isIdempotent(req); got != tt.want {
+            t.Errorf("isIdempotent(%s) = %v, want %v", tt.method, got, tt.want)
+        }
+    }
+}
+
+func TestShouldRetry(t *testing.T) {
+    cancelled, cancel := context.WithCancel(context.Background())
+    cancel()
+
+    tests := []struct {
+        name   string
+        method string
+        ctx    context.Context
+        status int
+        err    error
+        want   bool
+    }{
+        {"rate limited GET", http.MethodGet, context.Background(), http.StatusTooManyRequests, nil, true},
+        {"rate limited POST", http.MethodPost, context.Background(), http.StatusTooManyRequests, nil, true},
+        {"server error GET", http.MethodGet, context.Background(), http.StatusInternalServerError, nil, true},
+        {"bad gateway PUT", http.MethodPut, context.Background(), http.StatusBadGateway, nil, true},
+        {"unavailable DELETE", http.MethodDelete, context.Background(), http.StatusServiceUnavailable, nil, true},
+        {"server error POST", http.MethodPost, context.Background(), http.StatusInternalServerError, nil, false},
+        {"server error retry safe POST", http.MethodPost, retrySafe(context.Background()), http.StatusGatewayTimeout, nil, true},
+        {"not implemented", http.MethodGet, context.Background(), http.StatusNotImplemented, nil, false},
+        {"client error", http.MethodGet, context.Background(), http.StatusBadRequest, nil, false},
+        {"success", http.MethodGet, context.Background(), http.StatusOK, nil, false},
+        {"transport error GET", http.MethodGet, context.Background(), 0, errors.New("connection reset"), true},
+        {"transport error POST", http.MethodPost, context.Background(), 0, errors.New("connection reset"), false},
+        {"cancelled GET", http.MethodGet, cancelled, 0, context.Canceled, false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            req := httptest.NewRequest(tt.method, "/", nil).WithContext(tt.ctx)
+            var resp *http.Response
+            if tt.err == nil {
+                resp = &http.Response{StatusCode: tt.status}
+            }
+            if got := shouldRetry(req, resp, tt.err); got != tt.want {
+                t.Errorf("shouldRetry() = %v, want %v", got, tt.want)
+            }
+        })
+    }
+}
+
+func TestParseRetryAfter(t *testing.T) {
+    tests := []struct {
+        value  string
+        want   time.Duration
+        wantOK bool
+    }{
+        {"", 0, false},
+        {"0", 0, true},
+        {"7", 7 * time.Second, true},
+        {"-1", 0, false},
+        {"soon", 0, false},
+        {time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+    }
+
+    for _, tt := range tests {
+        got, ok := parseRetryAfter(tt.value)
+        if got != tt.want || ok != tt.wantOK {
+            t.Errorf("parseRetryAfter(%q) = %s, %v, want %s, %v", tt.value, got, ok, tt.want, tt.wantOK)
+        }
+    }
+
+    // HTTP dates are relative to now.
+    date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+    got, ok := parseRetryAfter(date)
+    if !ok || got <= 55*time.Second || got > time.Minute {
+        t.Errorf("parseRetryAfter(%q) = %s, %v, want about a minute", date, got, ok)
+    }
+}
+
+func TestRetryDelay(t *testing.T) {
+    c := NewClient("", WithRetries(DefaultMaxRetries, 5*time.Second))
+
+    withRetryAfter := func(value string) *http.Response {
+        return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+    }
+
+    if got := c.retryDelay(0, withRetryAfter("2")); got != 2*time.Second {
+        t.Errorf("expected the Retry-After delay, got %s", got)
+    }
+    if got := c.retryDelay(0, withRetryAfter("3600")); got != 5*time.Second {
+        t.Errorf("expected Retry-After to be capped at retry_max_wait, got %s", got)
+    }
+
+    tests := []struct {
+        attempt  int
+        min, max time.Duration
+    }{
+        {0, 500 * time.Millisecond, time.Second},
+        {1, time.Second, 2 * time.Second},
+        {2, 2 * time.Second, 4 * time.Second},
+        {3, 2500 * time.Millisecond, 5 * time.Second},
+        {100, 2500 * time.Millisecond, 5 * time.Second},
+    }
+    for _, tt := range tests {
+        for i := 0; i < 20; i++ {
+            got := c.retryDelay(tt.attempt, &http.Response{Header: http.Header{}})
+            if got < tt.min || got > tt.max {
+                t.Errorf("retryDelay(%d) = %s, want between %s and %s", tt.attempt, got, tt.min, tt.max)
+            }
+        }
+    }
+}
+
+// newRetryTestServer answers with the given status codes in order, and with 200 once they are used up. Every
+// failure asks to be retried right away so the tests don't wait for the backoff.
+func newRetryTestServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+    t.Helper()
+
+    var requests atomic.Int32
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        body, _ := io.ReadAll(r.Body)
+        n := int(requests.Add(1))
+        if n <= len(statuses) {
+            w.Header().Set("Retry-After", "0")
+            w.WriteHeader(statuses[n-1])
+            return
+        }
+        w.Write(body)
+    }))
+    t.Cleanup(server.Close)
+    return server, &requests
+}
+
+func TestDoWithRetries(t *testing.T) {
+    tests := []struct {
+        name         string
+        method       string
+        maxRetries   int
+        statuses     []int
+        wantStatus   int
+        wantRequests int32
+    }{
+        {"rate limited GET", http.MethodGet, 3, []int{429, 429}, http.StatusOK, 3},
+        {"rate limited POST", http.MethodPost, 3, []int{429}, http.StatusOK, 2},
+        {"server error PUT", http.MethodPut, 3, []int{503, 502}, http.StatusOK, 3},
+        {"server error POST", http.MethodPost, 3, []int{500}, http.StatusInternalServerError, 1},
+        {"retries exhausted", http.MethodGet, 2, []int{503, 503, 503, 503}, http.StatusServiceUnavailable, 3},
+        {"retries disabled", http.MethodGet, 0, []int{429}, http.StatusTooManyRequests, 1},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            server, requests := newRetryTestServer(t, tt.statuses...)
+            c := NewClient(server.URL, WithRetries(tt.maxRetries, time.Second))
+
+            req, err := http.NewRequest(tt.method, server.URL, bytes.NewBufferString(`{"key":"value"}`))
+            if err != nil {
+                t.Fatal(err)
+            }
+            resp, err := c.doWithRetries(req)
+            if err != nil {
+                t.Fatalf("sending request: %v", err)
+            }
+            body, _ := io.ReadAll(resp.Body)
+            resp.Body.Close()
+
+            if resp.StatusCode != tt.wantStatus {
+                t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+            }
+            if n := requests.Load(); n != tt.wantRequests {
+                t.Errorf("expected %d requests, got %d", tt.wantRequests, n)
+            }
+            // The body is sent again with every retry.
+            if resp.StatusCode == http.StatusOK && string(body) != `{"key":"value"}` {
+                t.Errorf("expected the request body to be replayed, got %q", body)
+            }
+        })
+    }
+}
+
+func TestDoWithRetriesCapsRetryAfter(t *testing.T) {
+    var requests atomic.Int32
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if requests.Add(1) == 1 {
+            w.Header().Set("Retry-After", "3600")
+            w.WriteHeader(http.StatusTooManyRequests)
+        }
+    }))
+    t.Cleanup(server.Close)
+
+    c := NewClient(server.URL, WithRetries(1, 10*time.Millisecond))
+    req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+    start := time.Now()
+    resp, err := c.doWithRetries(req)
+    if err != nil {
+        t.Fatalf("sending request: %v", err)
+    }
+    resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK || requests.Load() != 2 {
+        t.Errorf("expected a successful retry, got status %d after %d requests", resp.StatusCode, requests.Load())
+    }
+    if elapsed := time.Since(start); elapsed > 5*time.Second {
+        t.Errorf("expected the wait to be capped at retry_max_wait, took %s", elapsed)
+    }
+}
+
+func TestDoWithRetriesStopsWhenCancelled(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Retry-After", "3600")
+        w.WriteHeader(http.StatusTooManyRequests)
+    }))
+    t.Cleanup(server.Close)
+
+    ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+    defer cancel()
+
+    c := NewClient(server.URL, WithRetries(3, time.Hour))
+    req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, strings.NewReader(""))
+
+    if _, err := c.doWithRetries(req); !errors.Is(err, context.DeadlineExceeded) {
+        t.Errorf("expected the wait to stop with the context, got %v", err)
+    }
+}
@@ -34,20 +34,20 @@ type ParagonConfig struct {
 func (c *Client) GetSDKKeys(ctx context.Context, projectID string) ([]SDKKey, error) {
     url := fmt.Sprintf("%s/projects/%s/keys", c.baseURL, projectID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get SDK keys with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get SDK keys: %w", newAPIError(resp))
     }
 
     var sdkKeys []SDKKey
@@ -72,16 +72,16 @@ func (c *Client) CreateSDKKey(ctx context.Context, projectID string) (*SDKKey, e
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusCreated {
-        return nil, fmt.Errorf("failed to create SDK key with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to create SDK key: %w", newAPIError(resp))
     }
 
     var sdkKey SDKKey
@@ -100,20 +100,16 @@ func (c *Client) DeleteSDKKey(ctx context.Context, projectID, keyID string) erro
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return fmt.Errorf("failed to delete SDK key with status code: %d", resp.StatusCode)
-    }
-
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete SDK key with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete SDK key: %w", newAPIError(resp))
     }
 
     return nil
@@ -2,6 +2,7 @@
 package client
 
 import (
+    "bytes"
     "context"
     "encoding/json"
     "fmt"
@@ -26,16 +27,16 @@ func (c *Client) GetTeams(ctx context.Context) ([]Team, error) {
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get teams with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get teams: %w", newAPIError(resp))
     }
 
     var teams []Team
@@ -54,16 +55,16 @@ func (c *Client) GetTeamByID(ctx context.Context, teamID string) (*Team, error)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get team with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get team: %w", newAPIError(resp))
     }
 
     var team Team
@@ -74,3 +75,111 @@ func (c *Client) GetTeamByID(ctx context.Context, teamID string) (*Team, error)
 
     return &team, nil
 }
+
+type TeamRequest struct {
+    Name           string `json:"name"`
+    Website        string `json:"website,omitempty"`
+    OrganizationID string `json:"organizationId,omitempty"`
+}
+
+// CreateTeam creates an empty team in an organization. Unlike CreateProject, no project is created with it.
+func (c *Client) CreateTeam(ctx context.Context, organizationID, name, website string) (*Team, error) {
+    url := fmt.Sprintf("%s/teams?organizationId=%s", c.baseURL, organizationID)
+
+    reqBody := TeamRequest{
+        Name:           name,
+        Website:        website,
+        OrganizationID: organizationID,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        return nil, fmt.Errorf("failed to create team: %w", newAPIError(resp))
+    }
+
+    var team Team
+    err = json.NewDecoder(resp.Body).Decode(&team)
+    if err != nil {
+        return nil, err
+    }
+
+    return &team, nil
+}
+
+// UpdateTeamRequest is the body of a team update. The website is always sent, and an empty website clears it.
+type UpdateTeamRequest struct {
+    Name    string `json:"name"`
+    Website string `json:"website"`
+}
+
+// UpdateTeam renames a team and sets its website. The website is always sent, so an empty one removes it.
+func (c *Client) UpdateTeam(ctx context.Context, teamID, name, website string) (*Team, error) {
+    url := fmt.Sprintf("%s/teams/%s", c.baseURL, teamID)
+
+    reqBody := UpdateTeamRequest{
+        Name:    name,
+        Website: website,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to update team: %w", newAPIError(resp))
+    }
+
+    var team Team
+    err = json.NewDecoder(resp.Body).Decode(&team)
+    if err != nil {
+        return nil, err
+    }
+
+    return &team, nil
+}
+
+// DeleteTeam deletes a team along with its members and invites. Paragon refuses to delete a team which still has projects.
+func (c *Client) DeleteTeam(ctx context.Context, teamID string) error {
+    url := fmt.Sprintf("%s/teams/%s", c.baseURL, teamID)
+
+    req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+    if err != nil {
+        return err
+    }
+    c.authorize(req)
+
+    resp, err := c.do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("failed to delete team: %w", newAPIError(resp))
+    }
+
+    return nil
+}
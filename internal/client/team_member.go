@@ -37,20 +37,20 @@ type InviteTeamMemberRequest struct {
 func (c *Client) GetTeamMembers(ctx context.Context, teamID string) ([]TeamMember, error) {
     url := fmt.Sprintf("%s/teams/%s/members", c.baseURL, teamID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get team members with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get team members: %w", newAPIError(resp))
     }
 
     var members []TeamMember
@@ -65,20 +65,20 @@ func (c *Client) GetTeamMembers(ctx context.Context, teamID string) ([]TeamMembe
 func (c *Client) GetTeamInvites(ctx context.Context, teamID string) ([]TeamInvite, error) {
     url := fmt.Sprintf("%s/teams/%s/invite", c.baseURL, teamID)
 
-    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    req, err := http.NewRequestWithContext(cacheable(ctx), "GET", url, nil)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get team invites with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get team invites: %w", newAPIError(resp))
     }
 
     var invites []TeamInvite
@@ -104,16 +104,16 @@ func (c *Client) InviteTeamMember(ctx context.Context, teamID, role, email strin
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusCreated {
-        return nil, fmt.Errorf("failed to invite team member with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to invite team member: %w", newAPIError(resp))
     }
 
     var invites []TeamInvite
@@ -138,16 +138,16 @@ func (c *Client) UpdateTeamMemberRole(ctx context.Context, teamID, memberID, rol
         return nil, err
     }
     req.Header.Set("Content-Type", "application/json")
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to update team member role with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to update team member role: %w", newAPIError(resp))
     }
 
     var updatedMember TeamMember
@@ -167,43 +167,17 @@ func (c *Client) DeleteTeamMember(ctx context.Context, teamID, memberID string)
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return fmt.Errorf("status code: %d", http.StatusNotFound)
-    }
-
-    // Weirdly enough - if team member is not found, the status code is 403 with this body:
-    // {
-    //     "message": "Unable to find team member.",
-    //     "code": "13200",
-    //     "status": 403,
-    //     "meta": {
-    //         "teamMemberId": "<member_id>"
-    //     }
-    // }
-    if resp.StatusCode == http.StatusForbidden {
-        var errResp struct {
-            Message string `json:"message"`
-            Code    string `json:"code"`
-        }
-        if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-            return fmt.Errorf("failed to decode error response: %v", err)
-        }
-
-        if errResp.Message == "Unable to find team member." || errResp.Code == "13200" {
-            return fmt.Errorf("status code: %d", http.StatusNotFound)
-        }
-    }
-
+    // A missing team member is reported as a 403, see notFoundCodes.
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete team member with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete team member: %w", newAPIError(resp))
     }
 
     return nil
@@ -216,43 +190,17 @@ func (c *Client) DeleteTeamInvite(ctx context.Context, teamID, inviteID string)
     if err != nil {
         return err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return err
     }
     defer resp.Body.Close()
 
-    if resp.StatusCode == http.StatusNotFound {
-        return fmt.Errorf("status code: %d", http.StatusNotFound)
-    }
-
-    // Weirdly enough - if invite is not found, the status code is 403 with this body:
-//     {
-//     "message": "Unable to find invite.",
-//     "code": "13101",
-//     "status": 403,
-//     "meta": {
-//         "inviteId": "<invite_id>>"
-//     }
-// }
-    if resp.StatusCode == http.StatusForbidden {
-        var errResp struct {
-            Message string `json:"message"`
-            Code    string `json:"code"`
-        }
-        if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-            return fmt.Errorf("failed to decode error response: %v", err)
-        }
-
-        if errResp.Message == "Unable to find invite." || errResp.Code == "13101" {
-            return fmt.Errorf("status code: %d", http.StatusNotFound)
-        }
-    }
-
+    // A missing invite is reported as a 403, see notFoundCodes.
     if resp.StatusCode != http.StatusOK {
-        return fmt.Errorf("failed to delete team invite with status code: %d", resp.StatusCode)
+        return fmt.Errorf("failed to delete team invite: %w", newAPIError(resp))
     }
 
     return nil
@@ -0,0 +1,134 @@
+package client
+
+import (
+    "encoding/pem"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestNewHTTPClientTimeout(t *testing.T) {
+    httpClient, err := NewHTTPClient(TransportConfig{})
+    if err != nil {
+        t.Fatalf("building client: %v", err)
+    }
+    if httpClient.Timeout != DefaultRequestTimeout {
+        t.Errorf("expected the default timeout %s, got %s", DefaultRequestTimeout, httpClient.Timeout)
+    }
+
+    httpClient, err = NewHTTPClient(TransportConfig{Timeout: 5 * time.Second})
+    if err != nil {
+        t.Fatalf("building client: %v", err)
+    }
+    if httpClient.Timeout != 5*time.Second {
+        t.Errorf("expected the configured timeout, got %s", httpClient.Timeout)
+    }
+}
+
+func TestNewHTTPClientCACert(t *testing.T) {
+    server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+    t.Cleanup(server.Close)
+
+    get := func(config TransportConfig) error {
+        httpClient, err := NewHTTPClient(config)
+        if err != nil {
+            t.Fatalf("building client: %v", err)
+        }
+        resp, err := httpClient.Get(server.URL)
+        if err != nil {
+            return err
+        }
+        resp.Body.Close()
+        return nil
+    }
+
+    if err := get(TransportConfig{}); err == nil {
+        t.Errorf("expected the self-signed certificate to be rejected without a CA bundle")
+    }
+
+    caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+    if err := get(TransportConfig{CACertPEM: caPEM}); err != nil {
+        t.Errorf("expected the certificate to be trusted with the CA bundle, got %v", err)
+    }
+
+    if err := get(TransportConfig{InsecureSkipVerify: true}); err != nil {
+        t.Errorf("expected the certificate to be accepted without verification, got %v", err)
+    }
+}
+
+func TestNewHTTPClientErrors(t *testing.T) {
+    tests := []struct {
+        name    string
+        config  TransportConfig
+        wantErr string
+    }{
+        {"invalid CA bundle", TransportConfig{CACertPEM: []byte("not a certificate")}, "no valid PEM certificates found in the CA bundle"},
+        {"invalid proxy URL", TransportConfig{ProxyURL: "http://proxy:port"}, "invalid proxy URL"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            _, err := NewHTTPClient(tt.config)
+            if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+                t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+            }
+        })
+    }
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+    var proxiedHost string
+    proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        proxiedHost = r.URL.Host
+    }))
+    t.Cleanup(proxy.Close)
+
+    httpClient, err := NewHTTPClient(TransportConfig{ProxyURL: proxy.URL})
+    if err != nil {
+        t.Fatalf("building client: %v", err)
+    }
+    resp, err := httpClient.Get("http://paragon.example.com/projects")
+    if err != nil {
+        t.Fatalf("sending request through the proxy: %v", err)
+    }
+    resp.Body.Close()
+
+    if proxiedHost != "paragon.example.com" {
+        t.Errorf("expected the request to go through the proxy, got host %q", proxiedHost)
+    }
+}
+
+func TestWithExtraHeaders(t *testing.T) {
+    var received http.Header
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        received = r.Header.Clone()
+    }))
+    t.Cleanup(server.Close)
+
+    c := NewClient(server.URL, WithExtraHeaders(map[string]string{
+        "x-gateway-key": "gateway",
+        "X-Tenant":      "default",
+    }))
+
+    req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    // Headers set by the request itself are kept.
+    req.Header.Set("X-Tenant", "explicit")
+
+    resp, err := c.send(req)
+    if err != nil {
+        t.Fatalf("sending request: %v", err)
+    }
+    resp.Body.Close()
+
+    if got := received.Get("X-Gateway-Key"); got != "gateway" {
+        t.Errorf("expected the extra header to be sent, got %q", got)
+    }
+    if got := received.Get("X-Tenant"); got != "explicit" {
+        t.Errorf("expected the request header to win over the extra header, got %q", got)
+    }
+}
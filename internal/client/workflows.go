@@ -26,16 +26,16 @@ func (c *Client) GetWorkflows(ctx context.Context, projectID, integrationID stri
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+    c.authorize(req)
 
-    resp, err := c.httpClient.Do(req)
+    resp, err := c.do(req)
     if err != nil {
         return nil, err
     }
     defer resp.Body.Close()
 
     if resp.StatusCode != http.StatusOK {
-        return nil, fmt.Errorf("failed to get workflows with status code: %d", resp.StatusCode)
+        return nil, fmt.Errorf("failed to get workflows: %w", newAPIError(resp))
     }
 
     var workflows []Workflow
@@ -0,0 +1,155 @@
+package fakeparagon
+
+import (
+    "crypto/rand"
+    "encoding/hex"
+    "net/http"
+    "sort"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// cliKeyPrefix starts every CLI key, which tells them apart from the JWT access tokens.
+const cliKeyPrefix = "cli_key."
+
+type loginRequest struct {
+    Username string `json:"username"`
+    Password string `json:"password"`
+    Profile  string `json:"profile"`
+}
+
+// userByCredentials returns the user matching the login request, s.mu must be held.
+func (s *Server) userByCredentials(req loginRequest) *user {
+    for _, u := range s.users {
+        if u.Email == req.Username && u.Password == req.Password {
+            return u
+        }
+    }
+    return nil
+}
+
+func (s *Server) loginEmail(w http.ResponseWriter, r *http.Request) {
+    var req loginRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    u := s.userByCredentials(req)
+    if u == nil {
+        writeError(w, http.StatusUnauthorized, "", "Invalid email or password.", nil)
+        return
+    }
+
+    writeJSON(w, http.StatusCreated, client.AuthResponse{AccessToken: s.issueToken(u.ID)})
+}
+
+// loginCLI mints a CLI key named after the profile, in the default organization.
+func (s *Server) loginCLI(w http.ResponseWriter, r *http.Request) {
+    var req loginRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    u := s.userByCredentials(req)
+    if u == nil {
+        writeError(w, http.StatusUnauthorized, "", "Invalid email or password.", nil)
+        return
+    }
+
+    secret := make([]byte, 24)
+    _, _ = rand.Read(secret)
+    key := cliKeyPrefix + hex.EncodeToString(secret)
+
+    now := timestamp()
+    id := newID()
+    s.cliKeys[id] = &client.CLIKey{
+        ID:          id,
+        DateCreated: now,
+        DateUpdated: now,
+        UserID:      u.ID,
+        Name:        req.Profile,
+        Suffix:      key[len(key)-4:],
+    }
+    s.cliKeySecrets[id] = key
+    s.tokens[key] = u.ID
+
+    writeJSON(w, http.StatusCreated, client.CLIKeyResponse{Key: key})
+}
+
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    organizations := make([]client.Organization, 0, len(s.organizations))
+    for _, org := range s.organizations {
+        organizations = append(organizations, *org)
+    }
+    sort.Slice(organizations, func(i, j int) bool { return organizations[i].DateCreated < organizations[j].DateCreated })
+
+    writeJSON(w, http.StatusOK, organizations)
+}
+
+func (s *Server) listCLIKeys(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if _, ok := s.organizations[r.PathValue("organizationID")]; !ok {
+        notFound(w, "organization")
+        return
+    }
+
+    // Users only see the CLI keys they minted.
+    keys := make([]client.CLIKey, 0, len(s.cliKeys))
+    for _, key := range s.cliKeys {
+        if key.UserID == userID {
+            keys = append(keys, *key)
+        }
+    }
+    sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+    writeJSON(w, http.StatusOK, keys)
+}
+
+func (s *Server) updateCLIKey(w http.ResponseWriter, r *http.Request, userID string) {
+    var req struct {
+        Name string `json:"name"`
+    }
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    key, ok := s.cliKeys[r.PathValue("keyID")]
+    if !ok || key.UserID != userID {
+        notFound(w, "CLI key")
+        return
+    }
+    key.Name = req.Name
+    key.DateUpdated = timestamp()
+
+    writeJSON(w, http.StatusOK, key)
+}
+
+func (s *Server) deleteCLIKey(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    key, ok := s.cliKeys[r.PathValue("keyID")]
+    if !ok || key.UserID != userID {
+        notFound(w, "CLI key")
+        return
+    }
+    delete(s.cliKeys, key.ID)
+    delete(s.tokens, s.cliKeySecrets[key.ID])
+    delete(s.cliKeySecrets, key.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
@@ -0,0 +1,167 @@
+package fakeparagon
+
+import (
+    "fmt"
+    "net/http"
+    "sort"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// credentialRequest is the body of the credential endpoints, with values kept as sent.
+type credentialRequest struct {
+    Name          string                 `json:"name"`
+    Values        map[string]interface{} `json:"values"`
+    Provider      string                 `json:"provider"`
+    Scheme        string                 `json:"scheme"`
+    IntegrationID string                 `json:"integrationId"`
+}
+
+func (s *Server) listCredentials(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    credentials := make([]client.Credential, 0)
+    for _, credential := range s.credentials {
+        if credential.ProjectID == project.ID {
+            credentials = append(credentials, credential.Credential)
+        }
+    }
+    sort.Slice(credentials, func(i, j int) bool { return credentials[i].ID < credentials[j].ID })
+
+    writeJSON(w, http.StatusOK, credentials)
+}
+
+// requiredCredentialValues are the values the credentials of each scheme must have. Custom credentials take any
+// values.
+var requiredCredentialValues = map[string][]string{
+    client.CredentialSchemeOAuthApp: {"clientId", "clientSecret"},
+    client.CredentialSchemeAPIKey:   {"apiKey"},
+    client.CredentialSchemeBasic:    {"username", "password"},
+    client.CredentialSchemeCustom:   nil,
+}
+
+// putCredentials returns the handler of the endpoint of a credential scheme, which creates the credentials of an
+// integration with that scheme or replaces the existing ones.
+func (s *Server) putCredentials(scheme string) func(w http.ResponseWriter, r *http.Request, userID string) {
+    return func(w http.ResponseWriter, r *http.Request, userID string) {
+        var req credentialRequest
+        if !decodeBody(w, r, &req) {
+            return
+        }
+
+        if req.Name == "" {
+            writeError(w, http.StatusBadRequest, "", "A name is required.", nil)
+            return
+        }
+        if req.Scheme != scheme {
+            writeError(w, http.StatusBadRequest, "", fmt.Sprintf("Credentials of scheme '%s' can't be written to this endpoint.", req.Scheme), nil)
+            return
+        }
+        for _, key := range requiredCredentialValues[scheme] {
+            if value, ok := req.Values[key].(string); !ok || value == "" {
+                writeError(w, http.StatusBadRequest, "", fmt.Sprintf("The value '%s' is required.", key), nil)
+                return
+            }
+        }
+        if len(req.Values) == 0 {
+            writeError(w, http.StatusBadRequest, "", "Values are required.", nil)
+            return
+        }
+
+        s.mu.Lock()
+        defer s.mu.Unlock()
+
+        project, ok := s.project(w, r)
+        if !ok {
+            return
+        }
+
+        integration, ok := s.integrations[req.IntegrationID]
+        if !ok || integration.ProjectID != project.ID {
+            notFound(w, "integration")
+            return
+        }
+
+        now := timestamp()
+        var stored *credential
+        for _, existing := range s.credentials {
+            if existing.ProjectID == project.ID && existing.IntegrationID == req.IntegrationID && existing.Scheme == req.Scheme {
+                stored = existing
+                break
+            }
+        }
+        if stored == nil {
+            stored = &credential{
+                Credential: client.Credential{
+                    ID:            newID(),
+                    DateCreated:   now,
+                    ProjectID:     project.ID,
+                    IntegrationID: req.IntegrationID,
+                    Status:        "VALID",
+                },
+            }
+            s.credentials[stored.ID] = stored
+        }
+
+        stored.Name = req.Name
+        stored.Provider = req.Provider
+        stored.Scheme = req.Scheme
+        stored.DateUpdated = now
+        stored.Values = req.Values
+
+        writeJSON(w, http.StatusOK, stored.Credential)
+    }
+}
+
+func (s *Server) getDecryptedCredential(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    stored, ok := s.credentials[r.PathValue("credentialID")]
+    if !ok || stored.ProjectID != project.ID {
+        notFound(w, "credential")
+        return
+    }
+
+    writeJSON(w, http.StatusOK, client.DecryptedCredential{
+        ID:            stored.ID,
+        DateCreated:   stored.DateCreated,
+        DateUpdated:   stored.DateUpdated,
+        ProjectID:     stored.ProjectID,
+        Values:        stored.Values,
+        Provider:      stored.Provider,
+        IntegrationID: stored.IntegrationID,
+        Scheme:        stored.Scheme,
+        Status:        stored.Status,
+    })
+}
+
+func (s *Server) deleteCredential(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    stored, ok := s.credentials[r.PathValue("credentialID")]
+    if !ok || stored.ProjectID != project.ID {
+        notFound(w, "credential")
+        return
+    }
+    delete(s.credentials, stored.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
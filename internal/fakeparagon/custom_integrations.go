@@ -0,0 +1,160 @@
+package fakeparagon
+
+import (
+    "net/http"
+    "regexp"
+    "strings"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// customIntegration returns the integration installing the custom integration of the request path, answering
+// with a 404 when it doesn't exist in the project. s.mu must be held.
+func (s *Server) customIntegration(w http.ResponseWriter, r *http.Request) (*client.Integration, bool) {
+    project, ok := s.project(w, r)
+    if !ok {
+        return nil, false
+    }
+
+    for _, integration := range s.integrations {
+        if integration.ProjectID == project.ID && integration.CustomIntegrationID == r.PathValue("customIntegrationID") {
+            return integration, true
+        }
+    }
+    notFound(w, "custom integration")
+    return nil, false
+}
+
+// validCustomIntegration checks a custom integration request like Paragon does, answering with a 400 when it
+// is invalid. s.mu must be held.
+func (s *Server) validCustomIntegration(w http.ResponseWriter, projectID, customIntegrationID string, req *client.CustomIntegrationRequest) bool {
+    if req.Name == "" {
+        writeError(w, http.StatusBadRequest, "", "A name is required.", nil)
+        return false
+    }
+
+    switch req.AuthenticationType {
+    case client.CustomIntegrationAuthOAuth:
+        if req.OAuth == nil {
+            writeError(w, http.StatusBadRequest, "", "OAuth integrations require an OAuth configuration.", nil)
+            return false
+        }
+    case client.CustomIntegrationAuthAPIKey, client.CustomIntegrationAuthBasic, client.CustomIntegrationAuthNone:
+    default:
+        writeError(w, http.StatusBadRequest, "", "Unknown authentication type.", nil)
+        return false
+    }
+
+    for _, integration := range s.integrations {
+        custom := integration.CustomIntegration
+        if integration.ProjectID == projectID && custom != nil && custom.ID != customIntegrationID && custom.Slug == req.Slug {
+            writeError(w, http.StatusBadRequest, "", "A custom integration with this slug already exists.", nil)
+            return false
+        }
+    }
+    return true
+}
+
+func applyCustomIntegration(custom *client.CustomIntegration, req *client.CustomIntegrationRequest) {
+    custom.Name = req.Name
+    custom.IconURL = req.IconURL
+    custom.APIBaseURL = req.APIBaseURL
+    custom.AuthenticationType = req.AuthenticationType
+    custom.OAuth = req.OAuth
+    custom.APIKey = req.APIKey
+}
+
+func (s *Server) createCustomIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.CustomIntegrationRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    if req.Slug == "" {
+        req.Slug = strings.Trim(slugInvalidChars.ReplaceAllString(strings.ToLower(req.Name), "-"), "-")
+    }
+    if !s.validCustomIntegration(w, project.ID, "", &req) {
+        return
+    }
+
+    now := timestamp()
+    custom := &client.CustomIntegration{
+        ID:          newID(),
+        DateCreated: now,
+        DateUpdated: now,
+        ProjectID:   project.ID,
+        Slug:        req.Slug,
+    }
+    applyCustomIntegration(custom, &req)
+
+    integration := &client.Integration{
+        ID:                  newID(),
+        DateCreated:         now,
+        DateUpdated:         now,
+        ProjectID:           project.ID,
+        CustomIntegrationID: custom.ID,
+        Type:                "custom",
+        CustomIntegration:   custom,
+    }
+    s.integrations[integration.ID] = integration
+
+    writeJSON(w, http.StatusCreated, integration)
+}
+
+func (s *Server) updateCustomIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.CustomIntegrationRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    integration, ok := s.customIntegration(w, r)
+    if !ok {
+        return
+    }
+
+    // The slug can't be changed.
+    req.Slug = integration.CustomIntegration.Slug
+    if !s.validCustomIntegration(w, integration.ProjectID, integration.CustomIntegrationID, &req) {
+        return
+    }
+
+    now := timestamp()
+    applyCustomIntegration(integration.CustomIntegration, &req)
+    integration.CustomIntegration.DateUpdated = now
+    integration.DateUpdated = now
+
+    writeJSON(w, http.StatusOK, integration)
+}
+
+func (s *Server) deleteCustomIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    integration, ok := s.customIntegration(w, r)
+    if !ok {
+        return
+    }
+
+    // Uninstalling the integration removes its credentials as well.
+    for id, credential := range s.credentials {
+        if credential.IntegrationID == integration.ID {
+            delete(s.credentials, id)
+        }
+    }
+    delete(s.integrations, integration.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
@@ -0,0 +1,97 @@
+package fakeparagon
+
+import (
+    "net/http"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// eventDestination returns the event destination of the request path, answering with a 404 when it doesn't
+// exist in the project. s.mu must be held.
+func (s *Server) eventDestination(w http.ResponseWriter, r *http.Request) (*client.EventDestination, bool) {
+    project, ok := s.project(w, r)
+    if !ok {
+        return nil, false
+    }
+
+    destination, ok := s.destinations[r.PathValue("destinationID")]
+    if !ok || destination.ProjectID != project.ID {
+        notFound(w, "event destination")
+        return nil, false
+    }
+    return destination, true
+}
+
+func (s *Server) createEventDestination(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.CreateEventDestinationRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    now := timestamp()
+    destination := &client.EventDestination{
+        ID:            newID(),
+        ProjectID:     project.ID,
+        Type:          req.Type,
+        State:         "ACTIVE",
+        Configuration: req.Configuration,
+        DateCreated:   now,
+        DateUpdated:   now,
+    }
+    s.destinations[destination.ID] = destination
+
+    writeJSON(w, http.StatusCreated, destination)
+}
+
+func (s *Server) getEventDestination(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    destination, ok := s.eventDestination(w, r)
+    if !ok {
+        return
+    }
+
+    writeJSON(w, http.StatusOK, destination)
+}
+
+func (s *Server) updateEventDestination(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.CreateEventDestinationRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    destination, ok := s.eventDestination(w, r)
+    if !ok {
+        return
+    }
+    destination.Type = req.Type
+    destination.Configuration = req.Configuration
+    destination.DateUpdated = timestamp()
+
+    writeJSON(w, http.StatusOK, destination)
+}
+
+func (s *Server) deleteEventDestination(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    destination, ok := s.eventDestination(w, r)
+    if !ok {
+        return
+    }
+    delete(s.destinations, destination.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
@@ -0,0 +1,550 @@
+package fakeparagon
+
+import (
+    "crypto/rand"
+    "crypto/rsa"
+    "crypto/sha256"
+    "crypto/x509"
+    "encoding/hex"
+    "encoding/pem"
+    "net/http"
+    "sort"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// project returns the project of the request path, answering with a 404 when it doesn't exist. s.mu must be held.
+func (s *Server) project(w http.ResponseWriter, r *http.Request) (*client.Project, bool) {
+    project, ok := s.projects[r.PathValue("projectID")]
+    if !ok {
+        notFound(w, "project")
+        return nil, false
+    }
+    return project, true
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    teamID := r.URL.Query().Get("teamId")
+    if _, ok := s.teams[teamID]; !ok {
+        notFound(w, "team")
+        return
+    }
+
+    projects := make([]client.Project, 0)
+    for _, project := range s.projects {
+        if project.TeamID == teamID {
+            projects = append(projects, *project)
+        }
+    }
+    sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+
+    writeJSON(w, http.StatusOK, projects)
+}
+
+// addProject creates a Connect project in a team, s.mu must be held.
+func (s *Server) addProject(teamID, title, ownerID string) *client.Project {
+    now := timestamp()
+    project := &client.Project{
+        ID:               newID(),
+        Title:            title,
+        OwnerID:          ownerID,
+        TeamID:           teamID,
+        IsConnectProject: true,
+        DateCreated:      now,
+        DateUpdated:      now,
+    }
+    s.projects[project.ID] = project
+    return project
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.UpdateProjectTitleRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    teamID := r.URL.Query().Get("teamId")
+    if _, ok := s.teams[teamID]; !ok {
+        notFound(w, "team")
+        return
+    }
+    if req.Title == "" {
+        writeError(w, http.StatusBadRequest, "", "A title is required.", nil)
+        return
+    }
+
+    writeJSON(w, http.StatusCreated, s.addProject(teamID, req.Title, userID))
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+    if teamID := r.URL.Query().Get("teamId"); teamID != "" && teamID != project.TeamID {
+        notFound(w, "project")
+        return
+    }
+
+    writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.UpdateProjectTitleRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+    project.Title = req.Title
+    project.DateUpdated = timestamp()
+
+    writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+    delete(s.projects, project.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listSDKKeys(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    keys := make([]client.SDKKey, 0)
+    for _, key := range s.sdkKeys {
+        if key.ProjectID == project.ID {
+            listed := *key
+            listed.PrivateKey = ""
+            keys = append(keys, listed)
+        }
+    }
+    sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+    writeJSON(w, http.StatusOK, keys)
+}
+
+// createSDKKey generates an RSA signing key. Like Paragon, the private key is only returned on creation.
+func (s *Server) createSDKKey(w http.ResponseWriter, r *http.Request, userID string) {
+    privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+    if err != nil {
+        writeError(w, http.StatusInternalServerError, "", err.Error(), nil)
+        return
+    }
+    publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+    if err != nil {
+        writeError(w, http.StatusInternalServerError, "", err.Error(), nil)
+        return
+    }
+    privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+    if err != nil {
+        writeError(w, http.StatusInternalServerError, "", err.Error(), nil)
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    now := timestamp()
+    key := &client.SDKKey{
+        ID:        newID(),
+        ProjectID: project.ID,
+        AuthType:  "paragon",
+        AuthConfig: client.AuthConfig{
+            Paragon: client.ParagonConfig{
+                PublicKey:     string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})),
+                GeneratedDate: now,
+            },
+        },
+        DateCreated: now,
+        DateUpdated: now,
+    }
+    s.sdkKeys[key.ID] = key
+
+    created := *key
+    created.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDER}))
+
+    writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) deleteSDKKey(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    key, ok := s.sdkKeys[r.PathValue("keyID")]
+    if !ok || key.ProjectID != project.ID {
+        notFound(w, "SDK key")
+        return
+    }
+    delete(s.sdkKeys, key.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+// secretHash is the hash returned along with an environment secret, in place of its value.
+func secretHash(value string) string {
+    sum := sha256.Sum256([]byte(value))
+    return hex.EncodeToString(sum[:])
+}
+
+func (s *Server) listSecrets(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    secrets := make([]client.EnvironmentSecret, 0)
+    for _, secret := range s.secrets {
+        if secret.ProjectID == project.ID {
+            secrets = append(secrets, *secret)
+        }
+    }
+    sort.Slice(secrets, func(i, j int) bool { return secrets[i].Key < secrets[j].Key })
+
+    writeJSON(w, http.StatusOK, secrets)
+}
+
+func (s *Server) createSecret(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.CreateEnvironmentSecretRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    for _, secret := range s.secrets {
+        if secret.ProjectID == project.ID && secret.Key == req.Key {
+            writeError(w, http.StatusBadRequest, "", "A secret with this key already exists.", nil)
+            return
+        }
+    }
+
+    now := timestamp()
+    secret := &client.EnvironmentSecret{
+        ID:          newID(),
+        Key:         req.Key,
+        ProjectID:   project.ID,
+        Hash:        secretHash(req.Value),
+        DateCreated: now,
+        DateUpdated: now,
+    }
+    s.secrets[secret.ID] = secret
+    s.secretValues[secret.ID] = req.Value
+
+    writeJSON(w, http.StatusCreated, secret)
+}
+
+func (s *Server) updateSecret(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.UpdateEnvironmentSecretRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    secret, ok := s.secrets[r.PathValue("secretID")]
+    if !ok || secret.ProjectID != project.ID {
+        notFound(w, "secret")
+        return
+    }
+    secret.Key = req.Key
+    secret.Hash = secretHash(req.Value)
+    secret.DateUpdated = timestamp()
+    s.secretValues[secret.ID] = req.Value
+
+    writeJSON(w, http.StatusOK, secret)
+}
+
+func (s *Server) deleteSecret(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    secret, ok := s.secrets[r.PathValue("secretID")]
+    if !ok || secret.ProjectID != project.ID {
+        notFound(w, "secret")
+        return
+    }
+    delete(s.secrets, secret.ID)
+    delete(s.secretValues, secret.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+// SecretValue returns the value of an environment secret of the project, and whether the secret exists.
+func (s *Server) SecretValue(projectID, key string) (string, bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for id, secret := range s.secrets {
+        if secret.ProjectID == projectID && secret.Key == key {
+            return s.secretValues[id], true
+        }
+    }
+    return "", false
+}
+
+// SetSecretValue changes the value of an environment secret behind Terraform's back, and reports whether the
+// secret exists.
+func (s *Server) SetSecretValue(projectID, key, value string) bool {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for id, secret := range s.secrets {
+        if secret.ProjectID == projectID && secret.Key == key {
+            secret.Hash = secretHash(value)
+            secret.DateUpdated = timestamp()
+            s.secretValues[id] = value
+            return true
+        }
+    }
+    return false
+}
+
+// AddIntegration installs an integration of the given type, e.g. "salesforce", in a project.
+func (s *Server) AddIntegration(projectID, integrationType string) client.Integration {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    now := timestamp()
+    integration := &client.Integration{
+        ID:          newID(),
+        DateCreated: now,
+        DateUpdated: now,
+        ProjectID:   projectID,
+        Type:        integrationType,
+    }
+    s.integrations[integration.ID] = integration
+    return *integration
+}
+
+// AddWorkflow creates a workflow for an integration of a project.
+func (s *Server) AddWorkflow(projectID, integrationID, description string) client.Workflow {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    now := timestamp()
+    workflow := &client.Workflow{
+        ID:              newID(),
+        DateCreated:     now,
+        DateUpdated:     now,
+        Description:     description,
+        ProjectID:       projectID,
+        IntegrationID:   integrationID,
+        WorkflowVersion: 1,
+        Tags:            []string{},
+    }
+    if project, ok := s.projects[projectID]; ok {
+        workflow.TeamID = project.TeamID
+    }
+    s.workflows[workflow.ID] = workflow
+    return *workflow
+}
+
+func (s *Server) listIntegrations(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    integrations := make([]client.Integration, 0)
+    for _, integration := range s.integrations {
+        if integration.ProjectID == project.ID {
+            integrations = append(integrations, *integration)
+        }
+    }
+    sort.Slice(integrations, func(i, j int) bool { return integrations[i].Type < integrations[j].Type })
+
+    writeJSON(w, http.StatusOK, integrations)
+}
+
+// integration returns the integration of the request path, answering with a 404 when it doesn't exist in the
+// project. s.mu must be held.
+func (s *Server) integration(w http.ResponseWriter, r *http.Request) (*client.Integration, bool) {
+    project, ok := s.project(w, r)
+    if !ok {
+        return nil, false
+    }
+
+    integration, ok := s.integrations[r.PathValue("integrationID")]
+    if !ok || integration.ProjectID != project.ID {
+        notFound(w, "integration")
+        return nil, false
+    }
+    return integration, true
+}
+
+func (s *Server) getIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    integration, ok := s.integration(w, r)
+    if !ok {
+        return
+    }
+
+    writeJSON(w, http.StatusOK, integration)
+}
+
+func (s *Server) updateIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    var req struct {
+        IsActive bool `json:"isActive"`
+    }
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    integration, ok := s.integration(w, r)
+    if !ok {
+        return
+    }
+    integration.IsActive = req.IsActive
+    integration.DateUpdated = timestamp()
+
+    writeJSON(w, http.StatusOK, integration)
+}
+
+func (s *Server) createIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    var req struct {
+        Type string `json:"type"`
+    }
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    // Custom integrations are installed by creating them.
+    if req.Type == "" || req.Type == "custom" {
+        writeError(w, http.StatusBadRequest, "", "Unknown integration type.", nil)
+        return
+    }
+    for _, integration := range s.integrations {
+        if integration.ProjectID == project.ID && integration.Type == req.Type {
+            writeError(w, http.StatusBadRequest, "", "This integration is already installed.", nil)
+            return
+        }
+    }
+
+    now := timestamp()
+    integration := &client.Integration{
+        ID:          newID(),
+        DateCreated: now,
+        DateUpdated: now,
+        ProjectID:   project.ID,
+        Type:        req.Type,
+    }
+    s.integrations[integration.ID] = integration
+
+    writeJSON(w, http.StatusCreated, integration)
+}
+
+func (s *Server) deleteIntegration(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    integration, ok := s.integration(w, r)
+    if !ok {
+        return
+    }
+
+    // Uninstalling the integration removes its credentials as well.
+    for id, credential := range s.credentials {
+        if credential.IntegrationID == integration.ID {
+            delete(s.credentials, id)
+        }
+    }
+    delete(s.integrations, integration.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listWorkflows(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    project, ok := s.project(w, r)
+    if !ok {
+        return
+    }
+
+    integrationID := r.URL.Query().Get("integrationId")
+    workflows := make([]client.Workflow, 0)
+    for _, workflow := range s.workflows {
+        if workflow.ProjectID == project.ID && (integrationID == "" || workflow.IntegrationID == integrationID) {
+            workflows = append(workflows, *workflow)
+        }
+    }
+    sort.Slice(workflows, func(i, j int) bool { return workflows[i].ID < workflows[j].ID })
+
+    writeJSON(w, http.StatusOK, workflows)
+}
@@ -0,0 +1,380 @@
+// Package fakeparagon is an in-process, stateful stand-in for the Paragon API, so the client and the provider
+// can be exercised end to end without network access or a Paragon account.
+package fakeparagon
+
+import (
+    "crypto/rand"
+    "encoding/base64"
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Credentials of the user created by New.
+const (
+    DefaultEmail    = "admin@example.com"
+    DefaultPassword = "password"
+)
+
+// Server is a fake Paragon API backed by httptest.
+type Server struct {
+    *httptest.Server
+
+    // TokenTTL is the lifetime of the access tokens issued on login.
+    TokenTTL time.Duration
+
+    mu sync.Mutex
+
+    users         map[string]*user
+    tokens        map[string]string // access token or CLI key -> user ID
+    organizations map[string]*client.Organization
+    cliKeys       map[string]*client.CLIKey
+    cliKeySecrets map[string]string // CLI key ID -> key
+    teams         map[string]*client.Team
+    projects      map[string]*client.Project
+    members       map[string]*teamMember
+    invites       map[string]*client.TeamInvite
+    sdkKeys       map[string]*client.SDKKey
+    secrets       map[string]*client.EnvironmentSecret
+    secretValues  map[string]string
+    integrations  map[string]*client.Integration
+    credentials   map[string]*credential
+    workflows     map[string]*client.Workflow
+    destinations  map[string]*client.EventDestination
+
+    failures []*failure
+    // requests counts the requests received per method and path, see Requests.
+    requests map[string]int
+
+    // defaultUserID and defaultOrganizationID are the user created by New and its organization.
+    defaultUserID         string
+    defaultOrganizationID string
+}
+
+type user struct {
+    ID       string
+    Email    string
+    Password string
+}
+
+// credential is an integration credential along with its decrypted values.
+type credential struct {
+    client.Credential
+    Values map[string]interface{}
+}
+
+// failure is an injected error response, see FailNext.
+type failure struct {
+    method     string
+    path       string
+    status     int
+    retryAfter string
+    remaining  int
+}
+
+// New starts a fake Paragon API with one user (DefaultEmail/DefaultPassword) owning one organization.
+// The server is closed when the test finishes.
+func New(t interface{ Cleanup(func()) }) *Server {
+    s := &Server{
+        TokenTTL:      time.Hour,
+        users:         make(map[string]*user),
+        tokens:        make(map[string]string),
+        organizations: make(map[string]*client.Organization),
+        cliKeys:       make(map[string]*client.CLIKey),
+        cliKeySecrets: make(map[string]string),
+        teams:         make(map[string]*client.Team),
+        projects:      make(map[string]*client.Project),
+        members:       make(map[string]*teamMember),
+        invites:       make(map[string]*client.TeamInvite),
+        sdkKeys:       make(map[string]*client.SDKKey),
+        secrets:       make(map[string]*client.EnvironmentSecret),
+        secretValues:  make(map[string]string),
+        integrations:  make(map[string]*client.Integration),
+        credentials:   make(map[string]*credential),
+        workflows:     make(map[string]*client.Workflow),
+        destinations:  make(map[string]*client.EventDestination),
+        requests:      make(map[string]int),
+    }
+
+    s.defaultUserID = s.AddUser(DefaultEmail, DefaultPassword)
+    s.defaultOrganizationID = s.AddOrganization("default-organization").ID
+
+    s.Server = httptest.NewServer(s.routes())
+    t.Cleanup(s.Close)
+    return s
+}
+
+func (s *Server) routes() http.Handler {
+    mux := http.NewServeMux()
+
+    mux.HandleFunc("POST /auth/login/email", s.loginEmail)
+    mux.HandleFunc("POST /auth/login/cli", s.loginCLI)
+
+    mux.HandleFunc("GET /organizations", s.authed(s.listOrganizations))
+    mux.HandleFunc("GET /organizations/{organizationID}/cli-keys", s.authed(s.listCLIKeys))
+    mux.HandleFunc("PATCH /organizations/{organizationID}/cli-keys/{keyID}", s.authed(s.updateCLIKey))
+    mux.HandleFunc("DELETE /organizations/{organizationID}/cli-keys/{keyID}", s.authed(s.deleteCLIKey))
+
+    mux.HandleFunc("GET /teams", s.authed(s.listTeams))
+    mux.HandleFunc("POST /teams", s.authed(s.createTeam))
+    mux.HandleFunc("GET /teams/{teamID}", s.authed(s.getTeam))
+    mux.HandleFunc("PATCH /teams/{teamID}", s.authed(s.updateTeam))
+    mux.HandleFunc("DELETE /teams/{teamID}", s.authed(s.deleteTeam))
+    mux.HandleFunc("GET /teams/{teamID}/members", s.authed(s.listMembers))
+    mux.HandleFunc("PATCH /teams/{teamID}/members/{memberID}", s.authed(s.updateMember))
+    mux.HandleFunc("DELETE /teams/{teamID}/members/{memberID}", s.authed(s.deleteMember))
+    mux.HandleFunc("GET /teams/{teamID}/invite", s.authed(s.listInvites))
+    mux.HandleFunc("POST /teams/{teamID}/invite", s.authed(s.createInvites))
+    mux.HandleFunc("DELETE /teams/{teamID}/invite/{inviteID}", s.authed(s.deleteInvite))
+
+    mux.HandleFunc("GET /projects", s.authed(s.listProjects))
+    mux.HandleFunc("POST /projects", s.authed(s.createProject))
+    mux.HandleFunc("GET /projects/{projectID}", s.authed(s.getProject))
+    mux.HandleFunc("PATCH /projects/{projectID}", s.authed(s.updateProject))
+    mux.HandleFunc("DELETE /projects/{projectID}", s.authed(s.deleteProject))
+
+    mux.HandleFunc("GET /projects/{projectID}/keys", s.authed(s.listSDKKeys))
+    mux.HandleFunc("POST /projects/{projectID}/keys", s.authed(s.createSDKKey))
+    mux.HandleFunc("DELETE /projects/{projectID}/keys/{keyID}", s.authed(s.deleteSDKKey))
+
+    mux.HandleFunc("GET /projects/{projectID}/secrets", s.authed(s.listSecrets))
+    mux.HandleFunc("POST /projects/{projectID}/secrets", s.authed(s.createSecret))
+    mux.HandleFunc("PATCH /projects/{projectID}/secrets/{secretID}", s.authed(s.updateSecret))
+    mux.HandleFunc("DELETE /projects/{projectID}/secrets/{secretID}", s.authed(s.deleteSecret))
+
+    mux.HandleFunc("GET /projects/{projectID}/integrations", s.authed(s.listIntegrations))
+    mux.HandleFunc("POST /projects/{projectID}/integrations", s.authed(s.createIntegration))
+    mux.HandleFunc("GET /projects/{projectID}/integrations/{integrationID}", s.authed(s.getIntegration))
+    mux.HandleFunc("PATCH /projects/{projectID}/integrations/{integrationID}", s.authed(s.updateIntegration))
+    mux.HandleFunc("DELETE /projects/{projectID}/integrations/{integrationID}", s.authed(s.deleteIntegration))
+    mux.HandleFunc("POST /projects/{projectID}/custom-integrations", s.authed(s.createCustomIntegration))
+    mux.HandleFunc("PATCH /projects/{projectID}/custom-integrations/{customIntegrationID}", s.authed(s.updateCustomIntegration))
+    mux.HandleFunc("DELETE /projects/{projectID}/custom-integrations/{customIntegrationID}", s.authed(s.deleteCustomIntegration))
+
+    mux.HandleFunc("GET /projects/{projectID}/credentials", s.authed(s.listCredentials))
+    mux.HandleFunc("PUT /projects/{projectID}/credentials/oauth", s.authed(s.putCredentials(client.CredentialSchemeOAuthApp)))
+    mux.HandleFunc("PUT /projects/{projectID}/credentials/api-key", s.authed(s.putCredentials(client.CredentialSchemeAPIKey)))
+    mux.HandleFunc("PUT /projects/{projectID}/credentials/basic", s.authed(s.putCredentials(client.CredentialSchemeBasic)))
+    mux.HandleFunc("PUT /projects/{projectID}/credentials/custom", s.authed(s.putCredentials(client.CredentialSchemeCustom)))
+    mux.HandleFunc("GET /projects/{projectID}/credentials/{credentialID}/decrypted", s.authed(s.getDecryptedCredential))
+    mux.HandleFunc("DELETE /projects/{projectID}/credentials/{credentialID}", s.authed(s.deleteCredential))
+
+    mux.HandleFunc("GET /projects/{projectID}/workflows", s.authed(s.listWorkflows))
+
+    mux.HandleFunc("POST /projects/{projectID}/event-destinations", s.authed(s.createEventDestination))
+    mux.HandleFunc("GET /projects/{projectID}/event-destinations/{destinationID}", s.authed(s.getEventDestination))
+    mux.HandleFunc("PUT /projects/{projectID}/event-destinations/{destinationID}", s.authed(s.updateEventDestination))
+    mux.HandleFunc("DELETE /projects/{projectID}/event-destinations/{destinationID}", s.authed(s.deleteEventDestination))
+
+    return s.injectFailures(mux)
+}
+
+// FailNext makes the next count requests matching method and path answer with the given status before the
+// request is handled, e.g. to exercise retries. A non-empty retryAfter is sent as the Retry-After header.
+func (s *Server) FailNext(method, path string, status, count int, retryAfter string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.failures = append(s.failures, &failure{
+        method:     method,
+        path:       path,
+        status:     status,
+        retryAfter: retryAfter,
+        remaining:  count,
+    })
+}
+
+// Requests returns how many requests matching method and path the server received, failed ones included.
+func (s *Server) Requests(method, path string) int {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.requests[method+" "+path]
+}
+
+func (s *Server) injectFailures(next http.Handler) http.Handler {
+    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        s.mu.Lock()
+        s.requests[r.Method+" "+r.URL.Path]++
+        var injected *failure
+        for _, f := range s.failures {
+            if f.remaining > 0 && f.method == r.Method && f.path == r.URL.Path {
+                f.remaining--
+                injected = f
+                break
+            }
+        }
+        s.mu.Unlock()
+
+        if injected != nil {
+            if injected.retryAfter != "" {
+                w.Header().Set("Retry-After", injected.retryAfter)
+            }
+            writeError(w, injected.status, "", http.StatusText(injected.status), nil)
+            return
+        }
+        next.ServeHTTP(w, r)
+    })
+}
+
+// authed rejects requests without a valid access token or CLI key, like Paragon does.
+func (s *Server) authed(next func(w http.ResponseWriter, r *http.Request, userID string)) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+        s.mu.Lock()
+        userID, ok := s.tokens[token]
+        if ok && !strings.HasPrefix(token, cliKeyPrefix) {
+            ok = tokenValid(token)
+        }
+        s.mu.Unlock()
+
+        if !ok {
+            writeError(w, http.StatusUnauthorized, "", "Unauthorized", nil)
+            return
+        }
+        next(w, r, userID)
+    }
+}
+
+// AddUser registers a user that can log in and returns its ID.
+func (s *Server) AddUser(email, password string) string {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    u := &user{ID: newID(), Email: email, Password: password}
+    s.users[u.ID] = u
+    return u.ID
+}
+
+// AddOrganization creates an organization.
+func (s *Server) AddOrganization(name string) client.Organization {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    now := timestamp()
+    org := &client.Organization{
+        ID:          newID(),
+        DateCreated: now,
+        DateUpdated: now,
+        Name:        name,
+        Type:        "company",
+        Role:        "ADMIN",
+    }
+    s.organizations[org.ID] = org
+    return *org
+}
+
+// DefaultOrganizationID returns the ID of the organization created by New.
+func (s *Server) DefaultOrganizationID() string {
+    return s.defaultOrganizationID
+}
+
+// DefaultUserID returns the ID of the user created by New.
+func (s *Server) DefaultUserID() string {
+    return s.defaultUserID
+}
+
+// IssueToken returns an access token for the user, as if they logged in.
+func (s *Server) IssueToken(userID string) string {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.issueToken(userID)
+}
+
+// RevokeTokens invalidates every access token issued so far, as if they all expired.
+func (s *Server) RevokeTokens() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    for token := range s.tokens {
+        if !strings.HasPrefix(token, cliKeyPrefix) {
+            delete(s.tokens, token)
+        }
+    }
+}
+
+func (s *Server) issueToken(userID string) string {
+    u := s.users[userID]
+    now := time.Now()
+    claims := map[string]interface{}{
+        "id":    u.ID,
+        "email": u.Email,
+        "iat":   now.Unix(),
+        "exp":   now.Add(s.TokenTTL).Unix(),
+        "jti":   newID(),
+    }
+    header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+    payload, _ := json.Marshal(claims)
+
+    token := base64.RawURLEncoding.EncodeToString(header) + "." +
+        base64.RawURLEncoding.EncodeToString(payload) + "." +
+        base64.RawURLEncoding.EncodeToString([]byte("fake-signature"))
+    s.tokens[token] = u.ID
+    return token
+}
+
+// tokenValid checks the expiry of a token issued by issueToken.
+func tokenValid(token string) bool {
+    parts := strings.Split(token, ".")
+    if len(parts) != 3 {
+        return false
+    }
+    payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+    if err != nil {
+        return false
+    }
+    var claims struct {
+        Exp int64 `json:"exp"`
+    }
+    if err := json.Unmarshal(payload, &claims); err != nil {
+        return false
+    }
+    return time.Now().Unix() < claims.Exp
+}
+
+func newID() string {
+    b := make([]byte, 16)
+    _, _ = rand.Read(b)
+    return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func timestamp() string {
+    return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(status)
+    _ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError answers in Paragon's error format.
+func writeError(w http.ResponseWriter, status int, code, message string, meta map[string]interface{}) {
+    body := map[string]interface{}{
+        "message": message,
+        "status":  status,
+    }
+    if code != "" {
+        body["code"] = code
+    }
+    if meta != nil {
+        body["meta"] = meta
+    }
+    writeJSON(w, status, body)
+}
+
+func notFound(w http.ResponseWriter, what string) {
+    writeError(w, http.StatusNotFound, "", fmt.Sprintf("Unable to find %s.", what), nil)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+    if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+        writeError(w, http.StatusBadRequest, "", "Invalid request body: "+err.Error(), nil)
+        return false
+    }
+    return true
+}
@@ -0,0 +1,358 @@
+package fakeparagon_test
+
+import (
+    "context"
+    "errors"
+    "net/http"
+    "testing"
+    "time"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func newClient(t *testing.T, server *fakeparagon.Server) *client.Client {
+    t.Helper()
+
+    c := client.NewClient(server.URL, client.WithRetries(3, 10*time.Millisecond))
+    if err := c.Authenticate(context.Background(), fakeparagon.DefaultEmail, fakeparagon.DefaultPassword); err != nil {
+        t.Fatalf("authenticating: %v", err)
+    }
+    return c
+}
+
+func TestLoginRejectsWrongPassword(t *testing.T) {
+    server := fakeparagon.New(t)
+
+    c := client.NewClient(server.URL)
+    err := c.Authenticate(context.Background(), fakeparagon.DefaultEmail, "wrong")
+    if !errors.Is(err, client.ErrUnauthorized) {
+        t.Fatalf("expected ErrUnauthorized, got %v", err)
+    }
+}
+
+func TestProjectLifecycle(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    project, automateProject, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+    if automateProject != nil {
+        t.Errorf("expected no Automate project, got %+v", automateProject)
+    }
+
+    updated, err := c.UpdateProjectTitle(ctx, project.ID, project.TeamID, "renamed")
+    if err != nil {
+        t.Fatalf("renaming project: %v", err)
+    }
+    if updated.Title != "renamed" {
+        t.Errorf("expected title 'renamed', got %q", updated.Title)
+    }
+
+    if err := c.DeleteProject(ctx, project.ID, project.TeamID); err != nil {
+        t.Fatalf("deleting project: %v", err)
+    }
+    _, err = c.GetProjectByID(ctx, project.ID, project.TeamID)
+    if !errors.Is(err, client.ErrNotFound) {
+        t.Fatalf("expected ErrNotFound after delete, got %v", err)
+    }
+}
+
+func TestEnvironmentSecrets(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    project, _, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+
+    secret, err := c.CreateEnvironmentSecret(ctx, project.ID, "API_KEY", "one")
+    if err != nil {
+        t.Fatalf("creating secret: %v", err)
+    }
+    if _, err := c.CreateEnvironmentSecret(ctx, project.ID, "API_KEY", "two"); err == nil {
+        t.Error("expected creating a duplicate key to fail")
+    }
+
+    if _, err := c.UpdateEnvironmentSecret(ctx, project.ID, secret.ID, "API_KEY", "two"); err != nil {
+        t.Fatalf("updating secret: %v", err)
+    }
+    if value, _ := server.SecretValue(project.ID, "API_KEY"); value != "two" {
+        t.Errorf("expected value 'two', got %q", value)
+    }
+
+    if err := c.DeleteEnvironmentSecret(ctx, project.ID, secret.ID); err != nil {
+        t.Fatalf("deleting secret: %v", err)
+    }
+    err = c.DeleteEnvironmentSecret(ctx, project.ID, secret.ID)
+    if !errors.Is(err, client.ErrNotFound) {
+        t.Fatalf("expected ErrNotFound, got %v", err)
+    }
+}
+
+func TestMissingTeamMemberIsReportedAsForbidden(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    project, _, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+
+    for name, err := range map[string]error{
+        "member": c.DeleteTeamMember(ctx, project.TeamID, "missing"),
+        "invite": c.DeleteTeamInvite(ctx, project.TeamID, "missing"),
+    } {
+        var apiErr *client.APIError
+        if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+            t.Errorf("%s: expected a 403, got %v", name, err)
+        }
+        if !errors.Is(err, client.ErrNotFound) {
+            t.Errorf("%s: expected ErrNotFound, got %v", name, err)
+        }
+    }
+}
+
+func TestTeamInvites(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    project, _, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+
+    if _, err := c.InviteTeamMember(ctx, project.TeamID, "MEMBER", "user@example.com"); err != nil {
+        t.Fatalf("inviting: %v", err)
+    }
+    if !server.AcceptInvite(project.TeamID, "user@example.com") {
+        t.Fatal("expected the invite to be pending")
+    }
+
+    members, err := c.GetTeamMembers(ctx, project.TeamID)
+    if err != nil {
+        t.Fatalf("listing members: %v", err)
+    }
+    if len(members) != 2 {
+        t.Fatalf("expected the owner and the new member, got %+v", members)
+    }
+}
+
+func TestCLIKeyAuthentication(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    created, err := c.CreateCLIKey(ctx, "ci")
+    if err != nil {
+        t.Fatalf("creating CLI key: %v", err)
+    }
+
+    keyClient := client.NewClient(server.URL)
+    if err := keyClient.AuthenticateWithToken(ctx, created.Key); err != nil {
+        t.Fatalf("authenticating with the CLI key: %v", err)
+    }
+
+    keys, err := c.GetCLIKeys(ctx, server.DefaultOrganizationID())
+    if err != nil || len(keys) != 1 {
+        t.Fatalf("expected one CLI key, got %+v (%v)", keys, err)
+    }
+    if err := c.DeleteCLIKey(ctx, server.DefaultOrganizationID(), keys[0].ID); err != nil {
+        t.Fatalf("deleting CLI key: %v", err)
+    }
+
+    _, err = keyClient.GetOrganizations(ctx)
+    if !errors.Is(err, client.ErrUnauthorized) {
+        t.Fatalf("expected the deleted CLI key to be rejected, got %v", err)
+    }
+}
+
+func TestCLIKeysArePerUser(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    server.AddUser("other@example.com", "other-password")
+    other := client.NewClient(server.URL)
+    if err := other.Authenticate(ctx, "other@example.com", "other-password"); err != nil {
+        t.Fatalf("authenticating: %v", err)
+    }
+
+    if _, err := c.CreateCLIKey(ctx, "mine"); err != nil {
+        t.Fatalf("creating CLI key: %v", err)
+    }
+    if _, err := other.CreateCLIKey(ctx, "theirs"); err != nil {
+        t.Fatalf("creating CLI key: %v", err)
+    }
+
+    keys, err := c.GetCLIKeys(ctx, server.DefaultOrganizationID())
+    if err != nil || len(keys) != 1 || keys[0].Name != "mine" {
+        t.Fatalf("expected only the user's CLI key, got %+v (%v)", keys, err)
+    }
+
+    theirs, err := other.GetCLIKeys(ctx, server.DefaultOrganizationID())
+    if err != nil || len(theirs) != 1 || theirs[0].Name != "theirs" {
+        t.Fatalf("expected only the other user's CLI key, got %+v (%v)", theirs, err)
+    }
+    if _, err := c.UpdateCLIKey(ctx, server.DefaultOrganizationID(), theirs[0].ID, "renamed"); !errors.Is(err, client.ErrNotFound) {
+        t.Errorf("expected another user's CLI key to be not found on update, got %v", err)
+    }
+    if err := c.DeleteCLIKey(ctx, server.DefaultOrganizationID(), theirs[0].ID); !errors.Is(err, client.ErrNotFound) {
+        t.Errorf("expected another user's CLI key to be not found on delete, got %v", err)
+    }
+}
+
+func TestRetriesInjectedFailures(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+
+    server.FailNext(http.MethodGet, "/organizations", http.StatusServiceUnavailable, 2, "")
+    if _, err := c.GetOrganizations(context.Background()); err != nil {
+        t.Fatalf("expected the request to be retried, got %v", err)
+    }
+}
+
+func TestRefreshesRevokedToken(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+
+    server.RevokeTokens()
+    if _, err := c.GetOrganizations(context.Background()); err != nil {
+        t.Fatalf("expected the token to be refreshed, got %v", err)
+    }
+}
+
+func TestCustomIntegrationLifecycle(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    project, _, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+
+    integration, err := c.CreateCustomIntegration(ctx, project.ID, client.CustomIntegrationRequest{
+        Name:               "Acme CRM",
+        AuthenticationType: client.CustomIntegrationAuthNone,
+    })
+    if err != nil {
+        t.Fatalf("creating custom integration: %v", err)
+    }
+    if integration.CustomIntegration == nil || integration.CustomIntegration.Slug != "acme-crm" {
+        t.Fatalf("expected a custom integration with slug 'acme-crm', got %+v", integration.CustomIntegration)
+    }
+
+    // Slugs are unique within a project.
+    _, err = c.CreateCustomIntegration(ctx, project.ID, client.CustomIntegrationRequest{
+        Name:               "Acme CRM",
+        AuthenticationType: client.CustomIntegrationAuthNone,
+    })
+    if err == nil {
+        t.Fatal("expected creating a custom integration with a duplicate slug to fail")
+    }
+
+    if err := c.DeleteCustomIntegration(ctx, project.ID, integration.CustomIntegration.ID); err != nil {
+        t.Fatalf("deleting custom integration: %v", err)
+    }
+    _, err = c.GetIntegration(ctx, project.ID, integration.ID)
+    if !errors.Is(err, client.ErrNotFound) {
+        t.Fatalf("expected ErrNotFound after delete, got %v", err)
+    }
+}
+
+func TestIntegrationLifecycle(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    project, _, err := c.CreateProject(ctx, server.DefaultOrganizationID(), "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+
+    integration, err := c.CreateIntegration(ctx, project.ID, "hubspot")
+    if err != nil {
+        t.Fatalf("creating integration: %v", err)
+    }
+    if integration.Type != "hubspot" || integration.IsActive {
+        t.Errorf("expected an inactive hubspot integration, got %+v", integration)
+    }
+
+    // An integration type is installed at most once per project.
+    if _, err := c.CreateIntegration(ctx, project.ID, "hubspot"); err == nil {
+        t.Fatal("expected installing hubspot twice to fail")
+    }
+
+    if err := c.DeleteIntegration(ctx, project.ID, integration.ID); err != nil {
+        t.Fatalf("deleting integration: %v", err)
+    }
+    _, err = c.GetIntegration(ctx, project.ID, integration.ID)
+    if !errors.Is(err, client.ErrNotFound) {
+        t.Fatalf("expected ErrNotFound after delete, got %v", err)
+    }
+}
+
+func TestTeamLifecycle(t *testing.T) {
+    server := fakeparagon.New(t)
+    c := newClient(t, server)
+    ctx := context.Background()
+
+    team, err := c.CreateTeam(ctx, server.DefaultOrganizationID(), "customer", "")
+    if err != nil {
+        t.Fatalf("creating team: %v", err)
+    }
+    projects, err := c.GetProjects(ctx, team.ID)
+    if err != nil {
+        t.Fatalf("listing projects: %v", err)
+    }
+    if len(projects) != 0 {
+        t.Errorf("expected a team without projects, got %+v", projects)
+    }
+
+    updated, err := c.UpdateTeam(ctx, team.ID, "renamed", "https://example.com")
+    if err != nil {
+        t.Fatalf("renaming team: %v", err)
+    }
+    if updated.Name != "renamed" || updated.Website != "https://example.com" {
+        t.Errorf("expected the renamed team, got %+v", updated)
+    }
+
+    // An empty website removes it.
+    updated, err = c.UpdateTeam(ctx, team.ID, "renamed", "")
+    if err != nil {
+        t.Fatalf("removing website: %v", err)
+    }
+    if updated.Website != "" {
+        t.Errorf("expected the website to be removed, got %q", updated.Website)
+    }
+
+    // Teams can only be deleted once their projects are.
+    project, err := c.CreateProjectInTeam(ctx, team.ID, "example")
+    if err != nil {
+        t.Fatalf("creating project: %v", err)
+    }
+    if project.TeamID != team.ID {
+        t.Errorf("expected the project to be created in team %s, got %s", team.ID, project.TeamID)
+    }
+    if err := c.DeleteTeam(ctx, team.ID); err == nil {
+        t.Fatal("expected deleting a team with projects to fail")
+    }
+    if err := c.DeleteProject(ctx, project.ID, team.ID); err != nil {
+        t.Fatalf("deleting project: %v", err)
+    }
+    if err := c.DeleteTeam(ctx, team.ID); err != nil {
+        t.Fatalf("deleting team: %v", err)
+    }
+    _, err = c.GetTeamByID(ctx, team.ID)
+    if !errors.Is(err, client.ErrNotFound) {
+        t.Fatalf("expected ErrNotFound after delete, got %v", err)
+    }
+}
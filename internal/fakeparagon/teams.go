@@ -0,0 +1,347 @@
+package fakeparagon
+
+import (
+    "net/http"
+    "sort"
+    "strings"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Paragon answers with a 403 and one of these codes when a team member or an invite doesn't exist.
+const (
+    codeInviteNotFound = "13101"
+    codeMemberNotFound = "13200"
+)
+
+// teamMember is a team member along with the team it belongs to.
+type teamMember struct {
+    client.TeamMember
+    TeamID string
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    teams := make([]client.Team, 0, len(s.teams))
+    for _, team := range s.teams {
+        teams = append(teams, *team)
+    }
+    sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+
+    writeJSON(w, http.StatusOK, teams)
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    team, ok := s.teams[r.PathValue("teamID")]
+    if !ok {
+        notFound(w, "team")
+        return
+    }
+
+    writeJSON(w, http.StatusOK, team)
+}
+
+// createTeam creates a team. Given a project title, a Connect project is created in it as well, which is how
+// Paragon creates projects.
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request, userID string) {
+    var req struct {
+        client.CreateProjectRequest
+        Website string `json:"website"`
+    }
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    organizationID := r.URL.Query().Get("organizationId")
+    org, ok := s.organizations[organizationID]
+    if !ok {
+        notFound(w, "organization")
+        return
+    }
+    if req.Name == "" {
+        writeError(w, http.StatusBadRequest, "", "A name is required.", nil)
+        return
+    }
+
+    now := timestamp()
+    team := &client.Team{
+        ID:             newID(),
+        DateCreated:    now,
+        DateUpdated:    now,
+        Name:           req.Name,
+        Website:        req.Website,
+        OrganizationID: org.ID,
+        Organization:   *org,
+    }
+    s.teams[team.ID] = team
+
+    projects := make([]client.Project, 0)
+    if req.ProjectTitle != "" {
+        projects = append(projects, *s.addProject(team.ID, req.ProjectTitle, userID))
+    }
+
+    owner := s.users[userID]
+    memberID := newID()
+    s.members[memberID] = &teamMember{
+        TeamMember: client.TeamMember{
+            ID:             memberID,
+            Name:           owner.Email,
+            Email:          owner.Email,
+            UserID:         owner.ID,
+            Role:           "ADMIN",
+            OrganizationID: org.ID,
+        },
+        TeamID: team.ID,
+    }
+
+    writeJSON(w, http.StatusCreated, client.CreateProjectResponse{
+        ID:             team.ID,
+        DateCreated:    team.DateCreated,
+        DateUpdated:    team.DateUpdated,
+        Name:           team.Name,
+        Website:        team.Website,
+        OrganizationID: team.OrganizationID,
+        Projects:       projects,
+    })
+}
+
+// updateTeamRequest is the body of a team update, telling a missing website apart from an empty one.
+type updateTeamRequest struct {
+    Name    string  `json:"name"`
+    Website *string `json:"website"`
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request, userID string) {
+    var req updateTeamRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    team, ok := s.teams[r.PathValue("teamID")]
+    if !ok {
+        notFound(w, "team")
+        return
+    }
+    if req.Name == "" {
+        writeError(w, http.StatusBadRequest, "", "A name is required.", nil)
+        return
+    }
+    team.Name = req.Name
+    // Like Paragon, a website missing from the request is kept.
+    if req.Website != nil {
+        team.Website = *req.Website
+    }
+    team.DateUpdated = timestamp()
+
+    writeJSON(w, http.StatusOK, team)
+}
+
+// deleteTeam deletes a team along with its members and invites. Like Paragon, teams which still have projects
+// can't be deleted.
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    team, ok := s.teams[r.PathValue("teamID")]
+    if !ok {
+        notFound(w, "team")
+        return
+    }
+    for _, project := range s.projects {
+        if project.TeamID == team.ID {
+            writeError(w, http.StatusBadRequest, "", "The projects of the team must be deleted first.", nil)
+            return
+        }
+    }
+
+    for id, member := range s.members {
+        if member.TeamID == team.ID {
+            delete(s.members, id)
+        }
+    }
+    for id, invite := range s.invites {
+        if invite.Team.ID == team.ID {
+            delete(s.invites, id)
+        }
+    }
+    delete(s.teams, team.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listMembers(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    teamID := r.PathValue("teamID")
+    if _, ok := s.teams[teamID]; !ok {
+        notFound(w, "team")
+        return
+    }
+
+    members := make([]client.TeamMember, 0)
+    for _, member := range s.members {
+        if member.TeamID == teamID {
+            members = append(members, member.TeamMember)
+        }
+    }
+    sort.Slice(members, func(i, j int) bool { return members[i].Email < members[j].Email })
+
+    writeJSON(w, http.StatusOK, members)
+}
+
+func (s *Server) updateMember(w http.ResponseWriter, r *http.Request, userID string) {
+    var req struct {
+        Role string `json:"role"`
+    }
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    member, ok := s.members[r.PathValue("memberID")]
+    if !ok || member.TeamID != r.PathValue("teamID") {
+        writeError(w, http.StatusForbidden, codeMemberNotFound, "Team member not found.", nil)
+        return
+    }
+    member.Role = req.Role
+
+    writeJSON(w, http.StatusOK, member.TeamMember)
+}
+
+func (s *Server) deleteMember(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    member, ok := s.members[r.PathValue("memberID")]
+    if !ok || member.TeamID != r.PathValue("teamID") {
+        writeError(w, http.StatusForbidden, codeMemberNotFound, "Team member not found.", nil)
+        return
+    }
+    delete(s.members, member.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listInvites(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    teamID := r.PathValue("teamID")
+    if _, ok := s.teams[teamID]; !ok {
+        notFound(w, "team")
+        return
+    }
+
+    writeJSON(w, http.StatusOK, s.teamInvites(teamID))
+}
+
+// teamInvites returns the pending invites of a team, s.mu must be held.
+func (s *Server) teamInvites(teamID string) []client.TeamInvite {
+    invites := make([]client.TeamInvite, 0)
+    for _, invite := range s.invites {
+        if invite.Team.ID == teamID {
+            invites = append(invites, *invite)
+        }
+    }
+    sort.Slice(invites, func(i, j int) bool { return invites[i].Email < invites[j].Email })
+    return invites
+}
+
+func (s *Server) createInvites(w http.ResponseWriter, r *http.Request, userID string) {
+    var req client.InviteTeamMemberRequest
+    if !decodeBody(w, r, &req) {
+        return
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    teamID := r.PathValue("teamID")
+    team, ok := s.teams[teamID]
+    if !ok {
+        notFound(w, "team")
+        return
+    }
+
+    now := timestamp()
+    created := make([]client.TeamInvite, 0, len(req.Emails))
+    for _, email := range req.Emails {
+        for _, member := range s.members {
+            if member.TeamID == teamID && strings.EqualFold(member.Email, email) {
+                writeError(w, http.StatusBadRequest, "", "User is already a member of this team.", nil)
+                return
+            }
+        }
+
+        invite := &client.TeamInvite{
+            ID:          newID(),
+            DateCreated: now,
+            DateUpdated: now,
+            Status:      "PENDING",
+            Role:        req.Role,
+            Email:       email,
+            Team:        *team,
+        }
+        s.invites[invite.ID] = invite
+        created = append(created, *invite)
+    }
+
+    writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) deleteInvite(w http.ResponseWriter, r *http.Request, userID string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    invite, ok := s.invites[r.PathValue("inviteID")]
+    if !ok || invite.Team.ID != r.PathValue("teamID") {
+        writeError(w, http.StatusForbidden, codeInviteNotFound, "Invite not found.", nil)
+        return
+    }
+    delete(s.invites, invite.ID)
+
+    writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+// AcceptInvite turns the pending invite of email into a team member, as if the user accepted it.
+// It reports whether an invite was found.
+func (s *Server) AcceptInvite(teamID, email string) bool {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for id, invite := range s.invites {
+        if invite.Team.ID != teamID || !strings.EqualFold(invite.Email, email) {
+            continue
+        }
+        delete(s.invites, id)
+
+        memberID := newID()
+        s.members[memberID] = &teamMember{
+            TeamMember: client.TeamMember{
+                ID:             memberID,
+                Name:           invite.Email,
+                Email:          invite.Email,
+                UserID:         newID(),
+                Role:           invite.Role,
+                OrganizationID: invite.Team.OrganizationID,
+            },
+            TeamID: teamID,
+        }
+        return true
+    }
+    return false
+}
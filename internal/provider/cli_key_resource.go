@@ -2,8 +2,10 @@ package provider
 
 import (
     "context"
+    "errors"
     "fmt"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +21,7 @@ import (
 var (
     _ resource.Resource              = &cliKeyResource{}
     _ resource.ResourceWithConfigure = &cliKeyResource{}
+    _ resource.ResourceWithImportState = &cliKeyResource{}
 )
 
 // NewCLIKeyResource is a helper function to simplify the provider implementation.
@@ -198,6 +201,10 @@ func (r *cliKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
     // Retrieve the list of CLI keys for the organization
     cliKeys, err := r.client.GetCLIKeys(ctx, organizationID)
     if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
         resp.Diagnostics.AddError(
             "Error reading CLI keys",
             "Could not read CLI keys, unexpected error: "+err.Error(),
@@ -287,11 +294,61 @@ func (r *cliKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
     // Delete the CLI key
     err := r.client.DeleteCLIKey(ctx, organizationID, keyID)
-    if err != nil {
+    if err != nil && !errors.Is(err, client.ErrNotFound) {
         resp.Diagnostics.AddError(
             "Error deleting CLI key",
             "Could not delete CLI key, unexpected error: "+err.Error(),
         )
         return
     }
-}
\ No newline at end of file
+}
+
+// ImportState imports an existing CLI key by "organization_id/name". The key itself is only returned when it is
+// created, so it is empty for imported keys.
+func (r *cliKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    organizationID, keyName, ok := splitImportID(req, resp, "organization_id/name")
+    if !ok {
+        return
+    }
+
+    cliKeys, err := r.client.GetCLIKeys(ctx, organizationID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading CLI keys",
+            "Could not read CLI keys, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    var matches []client.CLIKey
+    for _, cliKey := range cliKeys {
+        if cliKey.Name == keyName {
+            matches = append(matches, cliKey)
+        }
+    }
+
+    // Names are only unique per user, so prefer the key of the authenticated user
+    if len(matches) > 1 {
+        if userID, err := r.client.GetUserIDFromToken(); err == nil {
+            var own []client.CLIKey
+            for _, cliKey := range matches {
+                if cliKey.UserID == userID {
+                    own = append(own, cliKey)
+                }
+            }
+            matches = own
+        }
+    }
+
+    if len(matches) != 1 {
+        resp.Diagnostics.AddError(
+            "Error importing CLI key",
+            fmt.Sprintf("Expected one CLI key named '%s' in organization '%s', found %d", keyName, organizationID, len(matches)),
+        )
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0].ID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), matches[0].Name)...)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/client"
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccCLIKeyResourceConfig(server *fakeparagon.Server, name string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_cli_key" "test" {
+  organization_id = %[1]q
+  name            = %[2]q
+}
+`, server.DefaultOrganizationID(), name)
+}
+
+func TestAccCLIKeyResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+
+	var keyID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_cli_key", func(attributes map[string]string) error {
+			keys, err := c.GetCLIKeys(context.Background(), attributes["organization_id"])
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				if key.ID == attributes["id"] {
+					return nil
+				}
+			}
+			return client.ErrNotFound
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCLIKeyResourceConfig(server, "acc-cli-key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_cli_key.test", "name", "acc-cli-key"),
+					resource.TestMatchResourceAttr("paragon_cli_key.test", "key", regexp.MustCompile(`^cli_key\.`)),
+					testAccStateAttr("paragon_cli_key.test", "id", &keyID),
+				),
+			},
+			{
+				Config: testAccCLIKeyResourceConfig(server, "acc-cli-key-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_cli_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_cli_key.test", "name", "acc-cli-key-renamed"),
+					resource.TestCheckResourceAttrPtr("paragon_cli_key.test", "id", &keyID),
+				),
+			},
+			{
+				// The key is only returned when it is created.
+				ResourceName:            "paragon_cli_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateID("paragon_cli_key.test", "organization_id", "name"),
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+			{
+				PreConfig: func() {
+					if err := c.DeleteCLIKey(context.Background(), server.DefaultOrganizationID(), keyID); err != nil {
+						t.Fatalf("deleting CLI key: %v", err)
+					}
+				},
+				Config: testAccCLIKeyResourceConfig(server, "acc-cli-key-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_cli_key.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+// current_user_data_source.go
+package provider
+
+import (
+    "context"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &currentUserDataSource{}
+    _ datasource.DataSourceWithConfigure = &currentUserDataSource{}
+)
+
+// NewCurrentUserDataSource is a helper function to simplify the provider implementation.
+func NewCurrentUserDataSource() datasource.DataSource {
+    return &currentUserDataSource{}
+}
+
+// currentUserDataSource is the data source implementation.
+type currentUserDataSource struct {
+    client *client.Client
+}
+
+// currentUserDataSourceModel maps the data source schema data.
+type currentUserDataSourceModel struct {
+    ID             types.String        `tfsdk:"id"`
+    Email          types.String        `tfsdk:"email"`
+    Organizations  []organizationModel `tfsdk:"organizations"`
+    Teams          []teamModel         `tfsdk:"teams"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *currentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the user the provider is authenticated as.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the user.",
+                Computed:    true,
+            },
+            "email": schema.StringAttribute{
+                Description: "Email address of the user.",
+                Computed:    true,
+            },
+            "organizations": schema.ListNestedAttribute{
+                Description: "The organizations the user belongs to.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: organizationAttributes(),
+                },
+            },
+            "teams": schema.ListNestedAttribute{
+                Description: "The teams the user belongs to.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: teamAttributes(),
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *currentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state currentUserDataSourceModel
+
+    claims, err := d.client.TokenClaims()
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Current User",
+            "The current user is only known when the provider authenticates with a username and password or an access token: "+err.Error(),
+        )
+        return
+    }
+
+    organizations, err := d.client.GetOrganizations(ctx)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Organizations",
+            err.Error(),
+        )
+        return
+    }
+
+    teams, err := d.client.GetTeams(ctx)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Teams",
+            err.Error(),
+        )
+        return
+    }
+
+    state.ID = types.StringValue(claims.UserID)
+    state.Email = types.StringValue(claims.Email)
+
+    state.Organizations = []organizationModel{}
+    for _, org := range organizations {
+        state.Organizations = append(state.Organizations, mapOrganizationToModel(org))
+    }
+
+    state.Teams = []teamModel{}
+    for _, team := range teams {
+        state.Teams = append(state.Teams, mapTeamToModel(team))
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
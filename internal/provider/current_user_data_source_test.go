@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-current-user")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_current_user" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_current_user.test", "id", server.DefaultUserID()),
+					resource.TestCheckResourceAttr("data.paragon_current_user.test", "email", fakeparagon.DefaultEmail),
+					resource.TestCheckResourceAttr("data.paragon_current_user.test", "organizations.#", "1"),
+					resource.TestCheckResourceAttr("data.paragon_current_user.test", "organizations.0.id", server.DefaultOrganizationID()),
+					resource.TestCheckResourceAttr("data.paragon_current_user.test", "teams.#", "1"),
+					resource.TestCheckResourceAttr("data.paragon_current_user.test", "teams.0.id", project.TeamID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCurrentUserDataSource_cliKey(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigCLIKey(t, server) + `
+data "paragon_current_user" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Unable to Read Current User`),
+			},
+		},
+	})
+}
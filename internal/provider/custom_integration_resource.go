@@ -0,0 +1,497 @@
+// custom_integration_resource.go
+package provider
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "regexp"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource                   = &customIntegrationResource{}
+    _ resource.ResourceWithConfigure      = &customIntegrationResource{}
+    _ resource.ResourceWithValidateConfig = &customIntegrationResource{}
+    _ resource.ResourceWithImportState    = &customIntegrationResource{}
+)
+
+// NewCustomIntegrationResource is a helper function to simplify the provider implementation.
+func NewCustomIntegrationResource() resource.Resource {
+    return &customIntegrationResource{}
+}
+
+// customIntegrationResource is the resource implementation.
+type customIntegrationResource struct {
+    client *client.Client
+}
+
+// customIntegrationResourceModel maps the resource schema data.
+type customIntegrationResourceModel struct {
+    ID                 types.String                 `tfsdk:"id"`
+    IntegrationID      types.String                 `tfsdk:"integration_id"`
+    ProjectID          types.String                 `tfsdk:"project_id"`
+    Name               types.String                 `tfsdk:"name"`
+    Slug               types.String                 `tfsdk:"slug"`
+    IconURL            types.String                 `tfsdk:"icon_url"`
+    APIBaseURL         types.String                 `tfsdk:"api_base_url"`
+    AuthenticationType types.String                 `tfsdk:"authentication_type"`
+    OAuth              *customIntegrationOAuthModel  `tfsdk:"oauth"`
+    APIKey             *customIntegrationAPIKeyModel `tfsdk:"api_key"`
+}
+
+type customIntegrationOAuthModel struct {
+    AuthorizationURL types.String `tfsdk:"authorization_url"`
+    TokenURL         types.String `tfsdk:"token_url"`
+    Scopes           types.List   `tfsdk:"scopes"`
+}
+
+type customIntegrationAPIKeyModel struct {
+    Fields []customIntegrationFieldModel `tfsdk:"fields"`
+}
+
+type customIntegrationFieldModel struct {
+    Key   types.String `tfsdk:"key"`
+    Label types.String `tfsdk:"label"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *customIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_custom_integration"
+}
+
+// Schema defines the schema for the resource.
+func (r *customIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a custom integration.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the custom integration.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "integration_id": schema.StringAttribute{
+                Description: "Identifier of the integration installing the custom integration in the project, used by paragon_integration_credentials and paragon_integration_status.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "name": schema.StringAttribute{
+                Description: "Name of the custom integration, shown in the Connect Portal.",
+                Required:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "slug": schema.StringAttribute{
+                Description: "Slug of the custom integration, used to reference it from the SDK. Defaults to the name in lower case. Changing it creates a new custom integration.",
+                Optional:    true,
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+                Validators: []validator.String{
+                    stringvalidator.RegexMatches(
+                        regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+                        "Must contain lower case letters and digits separated by dashes",
+                    ),
+                },
+            },
+            // Empty strings are rejected as the API returns them like unset values, see optionalString.
+            "icon_url": schema.StringAttribute{
+                Description: "URL of the icon of the custom integration.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "api_base_url": schema.StringAttribute{
+                Description: "Base URL of the API of the custom integration, requests sent through the proxy API are relative to it.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "authentication_type": schema.StringAttribute{
+                Description: "How users connect their accounts (oauth, api_key, basic, none).",
+                Required:    true,
+                Validators: []validator.String{
+                    stringvalidator.OneOf(
+                        client.CustomIntegrationAuthOAuth,
+                        client.CustomIntegrationAuthAPIKey,
+                        client.CustomIntegrationAuthBasic,
+                        client.CustomIntegrationAuthNone,
+                    ),
+                },
+            },
+            "oauth": schema.SingleNestedAttribute{
+                Description: "OAuth 2.0 configuration, required when authentication_type is oauth.",
+                Optional:    true,
+                Attributes: map[string]schema.Attribute{
+                    "authorization_url": schema.StringAttribute{
+                        Description: "URL users are sent to to authorize the integration.",
+                        Required:    true,
+                        Validators: []validator.String{
+                            stringvalidator.LengthAtLeast(1),
+                        },
+                    },
+                    "token_url": schema.StringAttribute{
+                        Description: "URL the authorization code is exchanged for an access token at.",
+                        Required:    true,
+                        Validators: []validator.String{
+                            stringvalidator.LengthAtLeast(1),
+                        },
+                    },
+                    "scopes": schema.ListAttribute{
+                        Description: "Scopes requested by default. Integration credentials can request others.",
+                        ElementType: types.StringType,
+                        Optional:    true,
+                        Validators: []validator.List{
+                            listvalidator.SizeAtLeast(1),
+                        },
+                    },
+                },
+            },
+            "api_key": schema.SingleNestedAttribute{
+                Description: "API key configuration, only allowed when authentication_type is api_key.",
+                Optional:    true,
+                Attributes: map[string]schema.Attribute{
+                    "fields": schema.ListNestedAttribute{
+                        Description: "Fields users fill in the Connect Portal to connect their account.",
+                        Required:    true,
+                        Validators: []validator.List{
+                            listvalidator.SizeAtLeast(1),
+                        },
+                        NestedObject: schema.NestedAttributeObject{
+                            Attributes: map[string]schema.Attribute{
+                                "key": schema.StringAttribute{
+                                    Description: "Key the value of the field is stored under.",
+                                    Required:    true,
+                                },
+                                "label": schema.StringAttribute{
+                                    Description: "Label of the field in the Connect Portal.",
+                                    Required:    true,
+                                },
+                            },
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// ValidateConfig checks the configuration blocks match the authentication type.
+func (r *customIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config customIntegrationResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() || config.AuthenticationType.IsUnknown() || config.AuthenticationType.IsNull() {
+        return
+    }
+
+    authenticationType := config.AuthenticationType.ValueString()
+    if authenticationType == client.CustomIntegrationAuthOAuth && config.OAuth == nil {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("oauth"),
+            "Missing OAuth configuration",
+            "The 'oauth' block is required when authentication_type is 'oauth'.",
+        )
+    }
+    if authenticationType != client.CustomIntegrationAuthOAuth && config.OAuth != nil {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("oauth"),
+            "Invalid authentication type",
+            fmt.Sprintf("The 'oauth' block is specified, but authentication_type is '%s'.", authenticationType),
+        )
+    }
+    if authenticationType != client.CustomIntegrationAuthAPIKey && config.APIKey != nil {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("api_key"),
+            "Invalid authentication type",
+            fmt.Sprintf("The 'api_key' block is specified, but authentication_type is '%s'.", authenticationType),
+        )
+    }
+}
+
+// customIntegrationRequest builds the API request from the plan.
+func customIntegrationRequest(ctx context.Context, plan customIntegrationResourceModel) (client.CustomIntegrationRequest, error) {
+    req := client.CustomIntegrationRequest{
+        Name:               plan.Name.ValueString(),
+        Slug:               plan.Slug.ValueString(),
+        IconURL:            plan.IconURL.ValueString(),
+        APIBaseURL:         plan.APIBaseURL.ValueString(),
+        AuthenticationType: plan.AuthenticationType.ValueString(),
+    }
+
+    if plan.OAuth != nil {
+        var scopes []string
+        if diags := plan.OAuth.Scopes.ElementsAs(ctx, &scopes, false); diags.HasError() {
+            return req, fmt.Errorf("could not read scopes")
+        }
+        req.OAuth = &client.CustomIntegrationOAuth{
+            AuthorizationURL: plan.OAuth.AuthorizationURL.ValueString(),
+            AccessTokenURL:   plan.OAuth.TokenURL.ValueString(),
+            Scopes:           strings.Join(scopes, " "),
+        }
+    }
+
+    if plan.APIKey != nil {
+        req.APIKey = &client.CustomIntegrationAPIKey{}
+        for _, field := range plan.APIKey.Fields {
+            req.APIKey.Fields = append(req.APIKey.Fields, client.CustomIntegrationField{
+                Key:   field.Key.ValueString(),
+                Label: field.Label.ValueString(),
+            })
+        }
+    }
+
+    return req, nil
+}
+
+// mapCustomIntegrationToModel sets the state from the integration installing the custom integration.
+func mapCustomIntegrationToModel(integration *client.Integration, state *customIntegrationResourceModel) {
+    custom := integration.CustomIntegration
+
+    state.ID = types.StringValue(custom.ID)
+    state.IntegrationID = types.StringValue(integration.ID)
+    state.ProjectID = types.StringValue(integration.ProjectID)
+    state.Name = types.StringValue(custom.Name)
+    state.Slug = types.StringValue(custom.Slug)
+    state.IconURL = optionalString(custom.IconURL)
+    state.APIBaseURL = optionalString(custom.APIBaseURL)
+    state.AuthenticationType = types.StringValue(custom.AuthenticationType)
+
+    state.OAuth = nil
+    if custom.OAuth != nil {
+        // Keep scopes unset rather than empty, as they are optional
+        scopes := types.ListNull(types.StringType)
+        if custom.OAuth.Scopes != "" {
+            var scopeValues []attr.Value
+            for _, scope := range strings.Split(custom.OAuth.Scopes, " ") {
+                scopeValues = append(scopeValues, types.StringValue(scope))
+            }
+            scopes = types.ListValueMust(types.StringType, scopeValues)
+        }
+        state.OAuth = &customIntegrationOAuthModel{
+            AuthorizationURL: types.StringValue(custom.OAuth.AuthorizationURL),
+            TokenURL:         types.StringValue(custom.OAuth.AccessTokenURL),
+            Scopes:           scopes,
+        }
+    }
+
+    state.APIKey = nil
+    if custom.APIKey != nil {
+        state.APIKey = &customIntegrationAPIKeyModel{}
+        for _, field := range custom.APIKey.Fields {
+            state.APIKey.Fields = append(state.APIKey.Fields, customIntegrationFieldModel{
+                Key:   types.StringValue(field.Key),
+                Label: types.StringValue(field.Label),
+            })
+        }
+    }
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan customIntegrationResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    createReq, err := customIntegrationRequest(ctx, plan)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error creating custom integration",
+            "Could not create custom integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    integration, err := r.client.CreateCustomIntegration(ctx, plan.ProjectID.ValueString(), createReq)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error creating custom integration",
+            "Could not create custom integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Map response body to schema and populate Computed attribute values
+    mapCustomIntegrationToModel(integration, &plan)
+
+    // Set state to fully populated data
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state customIntegrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // Retrieve the integration installing the custom integration
+    integration, err := r.client.GetIntegration(ctx, state.ProjectID.ValueString(), state.IntegrationID.ValueString())
+    if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error reading custom integration",
+            "Could not read custom integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    if integration.CustomIntegration == nil || integration.CustomIntegration.ID != state.ID.ValueString() {
+        resp.State.RemoveResource(ctx)
+        return
+    }
+
+    // Update the state with the latest data
+    mapCustomIntegrationToModel(integration, &state)
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan customIntegrationResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state customIntegrationResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    updateReq, err := customIntegrationRequest(ctx, plan)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating custom integration",
+            "Could not update custom integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    integration, err := r.client.UpdateCustomIntegration(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), updateReq)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating custom integration",
+            "Could not update custom integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Update the state with the updated data
+    mapCustomIntegrationToModel(integration, &plan)
+
+    // Set the updated state
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state customIntegrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    err := r.client.DeleteCustomIntegration(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+    if err != nil && !errors.Is(err, client.ErrNotFound) {
+        resp.Diagnostics.AddError(
+            "Error deleting custom integration",
+            "Could not delete custom integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+}
+
+// ImportState imports an existing custom integration by "project_id/slug".
+func (r *customIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, slug, ok := splitImportID(req, resp, "project_id/slug")
+    if !ok {
+        return
+    }
+
+    integrations, err := r.client.GetIntegrations(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integrations",
+            "Could not read integrations, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    for _, integration := range integrations {
+        if integration.CustomIntegration != nil && integration.CustomIntegration.Slug == slug {
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), integration.CustomIntegration.ID)...)
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), integration.ID)...)
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+            return
+        }
+    }
+
+    resp.Diagnostics.AddError(
+        "Custom integration not found",
+        fmt.Sprintf("No custom integration with slug '%s' exists in project '%s'", slug, projectID),
+    )
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccCustomIntegrationResourceConfig(server *fakeparagon.Server, projectID, name, scopes string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_custom_integration" "test" {
+  project_id          = %[1]q
+  name                = %[2]q
+  slug                = "acme"
+  api_base_url        = "https://api.acme.example.com"
+  authentication_type = "oauth"
+
+  oauth = {
+    authorization_url = "https://acme.example.com/oauth/authorize"
+    token_url         = "https://acme.example.com/oauth/token"
+    scopes            = %[3]s
+  }
+}
+`, projectID, name, scopes)
+}
+
+func TestAccCustomIntegrationResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-custom-integration")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			integrations, err := c.GetIntegrations(context.Background(), project.ID)
+			if err != nil {
+				return err
+			}
+			for _, integration := range integrations {
+				if integration.CustomIntegration != nil {
+					return fmt.Errorf("custom integration %s still exists", integration.CustomIntegration.ID)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomIntegrationResourceConfig(server, project.ID, "Acme", `["read", "write"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("paragon_custom_integration.test", "id"),
+					resource.TestCheckResourceAttrSet("paragon_custom_integration.test", "integration_id"),
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "slug", "acme"),
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "oauth.scopes.#", "2"),
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "oauth.scopes.1", "write"),
+					resource.TestCheckNoResourceAttr("paragon_custom_integration.test", "icon_url"),
+				),
+			},
+			{
+				Config: testAccCustomIntegrationResourceConfig(server, project.ID, "Acme CRM", `["read"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_custom_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "name", "Acme CRM"),
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "oauth.scopes.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "paragon_custom_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     project.ID + "/acme",
+			},
+			{
+				// A custom integration deleted outside of Terraform is created again.
+				PreConfig: func() {
+					integrations, err := c.GetIntegrations(context.Background(), project.ID)
+					if err != nil {
+						t.Fatalf("listing integrations: %v", err)
+					}
+					for _, integration := range integrations {
+						if integration.CustomIntegration == nil {
+							continue
+						}
+						if err := c.DeleteCustomIntegration(context.Background(), project.ID, integration.CustomIntegration.ID); err != nil {
+							t.Fatalf("deleting custom integration: %v", err)
+						}
+					}
+				},
+				Config: testAccCustomIntegrationResourceConfig(server, project.ID, "Acme CRM", `["read"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_custom_integration.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccCustomIntegrationResource_apiKey(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-custom-integration-api-key")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The integration created with the custom integration can be enabled.
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_custom_integration" "test" {
+  project_id          = %[1]q
+  name                = "Acme Billing"
+  authentication_type = "api_key"
+
+  api_key = {
+    fields = [
+      { key = "apiKey", label = "API Key" },
+      { key = "region", label = "Region" },
+    ]
+  }
+}
+
+resource "paragon_integration_status" "test" {
+  project_id     = %[1]q
+  integration_id = paragon_custom_integration.test.integration_id
+  active         = true
+}
+`, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "slug", "acme-billing"),
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "api_key.fields.#", "2"),
+					resource.TestCheckResourceAttr("paragon_custom_integration.test", "api_key.fields.1.key", "region"),
+					resource.TestCheckResourceAttrPair("paragon_integration_status.test", "id", "paragon_custom_integration.test", "integration_id"),
+					resource.TestCheckResourceAttr("paragon_integration_status.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomIntegrationResource_invalidConfig(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "paragon_custom_integration" "test" {
+  project_id          = "project"
+  name                = "Acme"
+  authentication_type = "oauth"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing OAuth configuration`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "paragon_custom_integration" "test" {
+  project_id          = "project"
+  name                = "Acme"
+  authentication_type = "basic"
+
+  api_key = {
+    fields = [{ key = "apiKey", label = "API Key" }]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid authentication type`),
+			},
+		},
+	})
+}
+
+func TestAccCustomIntegrationResource_emptyValues(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-custom-integration-empty")
+
+	// Empty values would be read back as unset, so they are rejected.
+	config := func(attributes string) string {
+		return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_custom_integration" "test" {
+  project_id          = %[1]q
+  name                = "Acme"
+  authentication_type = "oauth"
+  %[2]s
+}
+`, project.ID, attributes)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  icon_url = ""
+  oauth = {
+    authorization_url = "https://acme.example.com/oauth/authorize"
+    token_url         = "https://acme.example.com/oauth/token"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Attribute icon_url string length must be at least 1`),
+			},
+			{
+				Config: config(`
+  api_base_url = ""
+  oauth = {
+    authorization_url = "https://acme.example.com/oauth/authorize"
+    token_url         = "https://acme.example.com/oauth/token"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Attribute api_base_url string length must be at least 1`),
+			},
+			{
+				Config: config(`
+  oauth = {
+    authorization_url = "https://acme.example.com/oauth/authorize"
+    token_url         = "https://acme.example.com/oauth/token"
+    scopes            = []
+  }
+`),
+				ExpectError: regexp.MustCompile(`Attribute oauth.scopes list must contain at least 1 elements`),
+			},
+		},
+	})
+}
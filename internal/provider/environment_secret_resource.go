@@ -3,13 +3,18 @@ package provider
 
 import (
     "context"
-    "strings"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
     "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
     "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/tfsdk"
     "github.com/hashicorp/terraform-plugin-framework/types"
     "github.com/arielb135/terraform-provider-paragon/internal/client"
 )
@@ -18,8 +23,13 @@ import (
 var (
     _ resource.Resource              = &environmentSecretResource{}
     _ resource.ResourceWithConfigure = &environmentSecretResource{}
+    _ resource.ResourceWithImportState = &environmentSecretResource{}
+    _ resource.ResourceWithModifyPlan  = &environmentSecretResource{}
 )
 
+// environmentSecretHashKey is the private state key of the hash Paragon returned when the value was last written.
+const environmentSecretHashKey = "written_hash"
+
 // NewEnvironmentSecretResource is a helper function to simplify the provider implementation.
 func NewEnvironmentSecretResource() resource.Resource {
     return &environmentSecretResource{}
@@ -36,6 +46,8 @@ type environmentSecretResourceModel struct {
     ProjectID types.String `tfsdk:"project_id"`
     Key       types.String `tfsdk:"key"`
     Value     types.String `tfsdk:"value"`
+    ValueWO        types.String `tfsdk:"value_wo"`
+    ValueWOVersion types.String `tfsdk:"value_wo_version"`
     Hash      types.String `tfsdk:"hash"`
 }
 
@@ -80,15 +92,34 @@ func (r *environmentSecretResource) Schema(_ context.Context, _ resource.SchemaR
                 },
             },
             "value": schema.StringAttribute{
-                Description: "Value of the environment secret.",
-                Required:    true,
+                Description: "Value of the environment secret, stored in the state. Either value or value_wo must be set.",
+                Optional:    true,
                 Sensitive:   true,
                 Validators: []validator.String{
                     stringvalidator.LengthAtLeast(1),
+                    stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+                },
+            },
+            "value_wo": schema.StringAttribute{
+                Description: "Write-only value of the environment secret, never stored in the state. Requires Terraform 1.11 or later. " +
+                    "Changes are only written along with a change of value_wo_version.",
+                Optional:    true,
+                Sensitive:   true,
+                WriteOnly:   true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "value_wo_version": schema.StringAttribute{
+                Description: "Version of value_wo. Changing it writes the current value_wo to Paragon.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.AlsoRequires(path.MatchRoot("value_wo")),
                 },
             },
             "hash": schema.StringAttribute{
-                Description: "Hash of the environment secret.",
+                Description: "Hash of the environment secret. When it differs from the hash returned when the value was written, " +
+                    "the value was changed outside of Terraform and is written again.",
                 Computed:    true,
             },
         },
@@ -106,7 +137,11 @@ func (r *environmentSecretResource) Create(ctx context.Context, req resource.Cre
 
     projectID := plan.ProjectID.ValueString()
     key := plan.Key.ValueString()
-    value := plan.Value.ValueString()
+    value, diags := environmentSecretValue(ctx, req.Config, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
 
     // Create new environment secret
     secret, err := r.client.CreateEnvironmentSecret(ctx, projectID, key, value)
@@ -121,6 +156,7 @@ func (r *environmentSecretResource) Create(ctx context.Context, req resource.Cre
     // Map response body to schema and populate Computed attribute values
     plan.ID = types.StringValue(secret.ID)
     plan.Hash = types.StringValue(secret.Hash)
+    resp.Diagnostics.Append(setEnvironmentSecretHash(ctx, resp.Private, secret.Hash)...)
 
     // Set state to fully populated data
     diags = resp.State.Set(ctx, plan)
@@ -145,7 +181,7 @@ func (r *environmentSecretResource) Read(ctx context.Context, req resource.ReadR
     secrets, err := r.client.GetEnvironmentSecrets(ctx, projectID)
     if err != nil {
 
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.State.RemoveResource(ctx)
             return
         }
@@ -171,6 +207,23 @@ func (r *environmentSecretResource) Read(ctx context.Context, req resource.ReadR
         return
     }
 
+    // Secrets written before the hash was recorded, or imported, start from their current hash. Imported secrets
+    // have no hash yet and are written again anyway, but a change made to the others before now can't be detected.
+    writtenHash, diags := req.Private.GetKey(ctx, environmentSecretHashKey)
+    resp.Diagnostics.Append(diags...)
+    if writtenHash == nil {
+        if !state.Hash.IsNull() {
+            resp.Diagnostics.AddWarning(
+                "Environment secret changes not detected",
+                fmt.Sprintf("The hash returned when the value of environment secret '%s' was written wasn't recorded by "+
+                    "the version of the provider which wrote it. The current hash is used from now on, so a value changed "+
+                    "outside of Terraform before now is kept. Bump value_wo_version, or replace the resource with "+
+                    "terraform apply -replace, to write the configured value again.", secret.Key),
+            )
+        }
+        resp.Diagnostics.Append(setEnvironmentSecretHash(ctx, resp.Private, secret.Hash)...)
+    }
+
     // Update the state with the latest data
     state.Key = types.StringValue(secret.Key)
     state.Hash = types.StringValue(secret.Hash)
@@ -202,12 +255,16 @@ func (r *environmentSecretResource) Update(ctx context.Context, req resource.Upd
     projectID := state.ProjectID.ValueString()
     secretID := state.ID.ValueString()
     key := plan.Key.ValueString()
-    value := plan.Value.ValueString()
+    value, diags := environmentSecretValue(ctx, req.Config, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
 
     // Update the environment secret using the UpdateEnvironmentSecret function
     updatedSecret, err := r.client.UpdateEnvironmentSecret(ctx, projectID, secretID, key, value)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                 "Environment secret not found during update",
                 "The environment secret was not found while attempting to update it. This is an unexpected error.",
@@ -224,6 +281,7 @@ func (r *environmentSecretResource) Update(ctx context.Context, req resource.Upd
     // Update the state with the updated data
     plan.Hash = types.StringValue(updatedSecret.Hash)
     plan.ID = types.StringValue(updatedSecret.ID)
+    resp.Diagnostics.Append(setEnvironmentSecretHash(ctx, resp.Private, updatedSecret.Hash)...)
 
     // Set the updated state
     diags = resp.State.Set(ctx, plan)
@@ -233,6 +291,58 @@ func (r *environmentSecretResource) Update(ctx context.Context, req resource.Upd
     }
 }
 
+// ModifyPlan plans to write the value again when the hash of the secret no longer matches the hash returned when
+// it was written, i.e. when the value was changed outside of Terraform.
+func (r *environmentSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    writtenHash, diags := req.Private.GetKey(ctx, environmentSecretHashKey)
+    resp.Diagnostics.Append(diags...)
+    if writtenHash == nil {
+        return
+    }
+    var hash string
+    if err := json.Unmarshal(writtenHash, &hash); err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading environment secret hash",
+            "Could not decode the hash recorded in the private state: "+err.Error(),
+        )
+        return
+    }
+
+    var state environmentSecretResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if state.Hash.ValueString() != hash {
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hash"), types.StringUnknown())...)
+    }
+}
+
+// setEnvironmentSecretHash records the hash Paragon returned when the value was written in the private state.
+func setEnvironmentSecretHash(ctx context.Context, private interface {
+    SetKey(context.Context, string, []byte) diag.Diagnostics
+}, hash string) diag.Diagnostics {
+    value, _ := json.Marshal(hash)
+    return private.SetKey(ctx, environmentSecretHashKey, value)
+}
+
+// environmentSecretValue returns the value to write, from value or else from value_wo. Write-only values are only
+// available in the configuration, never in the plan.
+func environmentSecretValue(ctx context.Context, config tfsdk.Config, plan environmentSecretResourceModel) (string, diag.Diagnostics) {
+    if !plan.Value.IsNull() {
+        return plan.Value.ValueString(), nil
+    }
+
+    var valueWO types.String
+    diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+    return valueWO.ValueString(), diags
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *environmentSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
     var state environmentSecretResourceModel
@@ -248,7 +358,7 @@ func (r *environmentSecretResource) Delete(ctx context.Context, req resource.Del
     // Delete the environment secret using the DeleteEnvironmentSecret function
     err := r.client.DeleteEnvironmentSecret(ctx, projectID, secretID)
     if err != nil {
-        if !strings.Contains(err.Error(), "status code: 404") {
+        if !errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                 "Error deleting environment secret",
                 "Could not delete environment secret, unexpected error: "+err.Error(),
@@ -256,4 +366,36 @@ func (r *environmentSecretResource) Delete(ctx context.Context, req resource.Del
             return
         }
     }
-}
\ No newline at end of file
+}
+
+// ImportState imports an existing environment secret by "project_id/key". Paragon never returns the value of a
+// secret, so it is written again on the next apply.
+func (r *environmentSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, key, ok := splitImportID(req, resp, "project_id/key")
+    if !ok {
+        return
+    }
+
+    secrets, err := r.client.GetEnvironmentSecrets(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading environment secrets",
+            "Could not read environment secrets, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    for _, secret := range secrets {
+        if secret.Key == key {
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), secret.ID)...)
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), secret.Key)...)
+            return
+        }
+    }
+
+    resp.Diagnostics.AddError(
+        "Environment secret not found",
+        fmt.Sprintf("No environment secret with key '%s' exists in project '%s'", key, projectID),
+    )
+}
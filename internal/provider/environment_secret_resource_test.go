@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/client"
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccEnvironmentSecretResourceConfig(server *fakeparagon.Server, projectID, value string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_environment_secret" "test" {
+  project_id = %[1]q
+  key        = "API_TOKEN"
+  value      = %[2]q
+}
+`, projectID, value)
+}
+
+// testAccCheckSecretValue checks the value stored by the fake server, as the API never returns it.
+func testAccCheckSecretValue(server *fakeparagon.Server, projectID, key, expected string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		value, ok := server.SecretValue(projectID, key)
+		if !ok {
+			return fmt.Errorf("environment secret %s not found", key)
+		}
+		if value != expected {
+			return fmt.Errorf("expected environment secret %s to be %q, got %q", key, expected, value)
+		}
+		return nil
+	}
+}
+
+func TestAccEnvironmentSecretResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-environment-secret")
+
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_environment_secret", func(attributes map[string]string) error {
+			if _, ok := server.SecretValue(attributes["project_id"], attributes["key"]); ok {
+				return nil
+			}
+			return client.ErrNotFound
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentSecretResourceConfig(server, project.ID, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_environment_secret.test", "key", "API_TOKEN"),
+					resource.TestCheckResourceAttrSet("paragon_environment_secret.test", "hash"),
+					testAccCheckSecretValue(server, project.ID, "API_TOKEN", "first"),
+					testAccStateAttr("paragon_environment_secret.test", "id", &secretID),
+				),
+			},
+			{
+				Config: testAccEnvironmentSecretResourceConfig(server, project.ID, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("paragon_environment_secret.test", "id", &secretID),
+					testAccCheckSecretValue(server, project.ID, "API_TOKEN", "second"),
+				),
+			},
+			{
+				// A value changed outside of Terraform changes the hash, and is written again.
+				PreConfig: func() {
+					if _, err := c.UpdateEnvironmentSecret(context.Background(), project.ID, secretID, "API_TOKEN", "changed"); err != nil {
+						t.Fatalf("updating environment secret: %v", err)
+					}
+				},
+				Config: testAccEnvironmentSecretResourceConfig(server, project.ID, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secret.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("paragon_environment_secret.test", tfjsonpath.New("hash")),
+					},
+				},
+				Check: testAccCheckSecretValue(server, project.ID, "API_TOKEN", "second"),
+			},
+			{
+				// Paragon never returns the value of a secret.
+				ResourceName:            "paragon_environment_secret.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateID("paragon_environment_secret.test", "project_id", "key"),
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			{
+				PreConfig: func() {
+					if err := c.DeleteEnvironmentSecret(context.Background(), project.ID, secretID); err != nil {
+						t.Fatalf("deleting environment secret: %v", err)
+					}
+				},
+				Config: testAccEnvironmentSecretResourceConfig(server, project.ID, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secret.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: testAccCheckSecretValue(server, project.ID, "API_TOKEN", "second"),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentSecretWriteOnlyConfig(server *fakeparagon.Server, projectID, value, version string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_environment_secret" "test" {
+  project_id       = %[1]q
+  key              = "API_TOKEN"
+  value_wo         = %[2]q
+  value_wo_version = %[3]q
+}
+`, projectID, value, version)
+}
+
+// testAccCheckNotInState checks that no attribute of any resource in the state holds the value.
+func testAccCheckNotInState(value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			for key, attribute := range rs.Primary.Attributes {
+				if strings.Contains(attribute, value) {
+					return fmt.Errorf("%s.%s holds the write-only value", name, key)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccEnvironmentSecretResource_writeOnly(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-environment-secret-write-only")
+
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_environment_secret" "test" {
+  project_id = %[1]q
+  key        = "API_TOKEN"
+  value      = "first"
+  value_wo   = "first"
+}
+`, project.ID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccEnvironmentSecretWriteOnlyConfig(server, project.ID, "first-write-only", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("paragon_environment_secret.test", "value"),
+					resource.TestCheckNoResourceAttr("paragon_environment_secret.test", "value_wo"),
+					resource.TestCheckResourceAttr("paragon_environment_secret.test", "value_wo_version", "1"),
+					testAccCheckNotInState("first-write-only"),
+					testAccCheckSecretValue(server, project.ID, "API_TOKEN", "first-write-only"),
+				),
+			},
+			{
+				// Write-only values are not compared, so a new value is only written with a new version.
+				Config: testAccEnvironmentSecretWriteOnlyConfig(server, project.ID, "second-write-only", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: testAccCheckSecretValue(server, project.ID, "API_TOKEN", "first-write-only"),
+			},
+			{
+				Config: testAccEnvironmentSecretWriteOnlyConfig(server, project.ID, "second-write-only", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotInState("second-write-only"),
+					testAccCheckSecretValue(server, project.ID, "API_TOKEN", "second-write-only"),
+					testAccStateAttr("paragon_environment_secret.test", "id", &secretID),
+				),
+			},
+			{
+				// Changes outside of Terraform are detected from the hash, without comparing the write-only value.
+				PreConfig: func() {
+					if _, err := c.UpdateEnvironmentSecret(context.Background(), project.ID, secretID, "API_TOKEN", "changed"); err != nil {
+						t.Fatalf("updating environment secret: %v", err)
+					}
+				},
+				Config: testAccEnvironmentSecretWriteOnlyConfig(server, project.ID, "second-write-only", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckSecretValue(server, project.ID, "API_TOKEN", "second-write-only"),
+			},
+			{
+				// Switching to a value stored in the state writes it.
+				Config: testAccEnvironmentSecretResourceConfig(server, project.ID, "third"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("paragon_environment_secret.test", "value_wo_version"),
+					testAccCheckSecretValue(server, project.ID, "API_TOKEN", "third"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+// environment_secrets_data_source.go
+package provider
+
+import (
+    "context"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &environmentSecretsDataSource{}
+    _ datasource.DataSourceWithConfigure = &environmentSecretsDataSource{}
+)
+
+// NewEnvironmentSecretsDataSource is a helper function to simplify the provider implementation.
+func NewEnvironmentSecretsDataSource() datasource.DataSource {
+    return &environmentSecretsDataSource{}
+}
+
+// environmentSecretsDataSource is the data source implementation.
+type environmentSecretsDataSource struct {
+    client *client.Client
+}
+
+// environmentSecretsDataSourceModel maps the data source schema data.
+type environmentSecretsDataSourceModel struct {
+    ProjectID types.String                        `tfsdk:"project_id"`
+    Prefix    types.String                        `tfsdk:"prefix"`
+    Secrets   map[string]environmentSecretModel   `tfsdk:"secrets"`
+}
+
+type environmentSecretModel struct {
+    ID          types.String `tfsdk:"id"`
+    Key         types.String `tfsdk:"key"`
+    Hash        types.String `tfsdk:"hash"`
+    DateCreated types.String `tfsdk:"date_created"`
+    DateUpdated types.String `tfsdk:"date_updated"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *environmentSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *environmentSecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_environment_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *environmentSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the environment secrets of a project, without their values.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "prefix": schema.StringAttribute{
+                Description: "Only fetch the secrets whose key starts with the prefix.",
+                Optional:    true,
+            },
+            "secrets": schema.MapNestedAttribute{
+                Description: "The map of environment secrets keyed by their key.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{
+                            Description: "Identifier of the environment secret.",
+                            Computed:    true,
+                        },
+                        "key": schema.StringAttribute{
+                            Description: "Key of the environment secret.",
+                            Computed:    true,
+                        },
+                        "hash": schema.StringAttribute{
+                            Description: "Hash of the value of the environment secret.",
+                            Computed:    true,
+                        },
+                        "date_created": schema.StringAttribute{
+                            Description: "Date when the environment secret was created.",
+                            Computed:    true,
+                        },
+                        "date_updated": schema.StringAttribute{
+                            Description: "Date when the environment secret was last updated.",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *environmentSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state environmentSecretsDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    secrets, err := d.client.GetEnvironmentSecrets(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Environment Secrets",
+            err.Error(),
+        )
+        return
+    }
+
+    prefix := state.Prefix.ValueString()
+    state.Secrets = make(map[string]environmentSecretModel)
+    for _, secret := range secrets {
+        if !strings.HasPrefix(secret.Key, prefix) {
+            continue
+        }
+        state.Secrets[secret.Key] = environmentSecretModel{
+            ID:          types.StringValue(secret.ID),
+            Key:         types.StringValue(secret.Key),
+            Hash:        types.StringValue(secret.Hash),
+            DateCreated: types.StringValue(secret.DateCreated),
+            DateUpdated: types.StringValue(secret.DateUpdated),
+        }
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentSecretsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-environment-secrets-data")
+
+	for key, value := range map[string]string{"STRIPE_API_KEY": "stripe", "STRIPE_WEBHOOK_SECRET": "webhook", "SLACK_TOKEN": "slack"} {
+		if _, err := c.CreateEnvironmentSecret(context.Background(), project.ID, key, value); err != nil {
+			t.Fatalf("creating environment secret: %v", err)
+		}
+	}
+	stripeKeyID := testAccSecretID(t, server, project.ID, "STRIPE_API_KEY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_environment_secrets" "all" {
+  project_id = %[1]q
+}
+
+data "paragon_environment_secrets" "stripe" {
+  project_id = %[1]q
+  prefix     = "STRIPE_"
+}
+`, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_environment_secrets.all", "secrets.%", "3"),
+					resource.TestCheckResourceAttr("data.paragon_environment_secrets.stripe", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("data.paragon_environment_secrets.stripe", "secrets.STRIPE_API_KEY.id", stripeKeyID),
+					resource.TestCheckResourceAttr("data.paragon_environment_secrets.stripe", "secrets.STRIPE_API_KEY.key", "STRIPE_API_KEY"),
+					resource.TestCheckResourceAttrSet("data.paragon_environment_secrets.stripe", "secrets.STRIPE_API_KEY.hash"),
+					resource.TestCheckResourceAttrSet("data.paragon_environment_secrets.stripe", "secrets.STRIPE_API_KEY.date_created"),
+					resource.TestCheckResourceAttrSet("data.paragon_environment_secrets.stripe", "secrets.STRIPE_API_KEY.date_updated"),
+					resource.TestCheckNoResourceAttr("data.paragon_environment_secrets.stripe", "secrets.SLACK_TOKEN.id"),
+				),
+			},
+			{
+				// Preconditions can require keys to exist.
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_environment_secrets" "stripe" {
+  project_id = %[1]q
+  prefix     = "STRIPE_"
+
+  lifecycle {
+    postcondition {
+      condition     = contains(keys(self.secrets), "STRIPE_PUBLISHABLE_KEY")
+      error_message = "STRIPE_PUBLISHABLE_KEY must be set."
+    }
+  }
+}
+`, project.ID),
+				ExpectError: regexp.MustCompile(`STRIPE_PUBLISHABLE_KEY must be set`),
+			},
+		},
+	})
+}
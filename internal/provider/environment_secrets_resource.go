@@ -0,0 +1,477 @@
+// environment_secrets_resource.go
+package provider
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "sync"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "golang.org/x/sync/errgroup"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource                = &environmentSecretsResource{}
+    _ resource.ResourceWithConfigure   = &environmentSecretsResource{}
+    _ resource.ResourceWithImportState = &environmentSecretsResource{}
+    _ resource.ResourceWithModifyPlan  = &environmentSecretsResource{}
+)
+
+const (
+    // environmentSecretsHashesKey is the private state key of the hashes Paragon returned when the values were
+    // last written, by key.
+    environmentSecretsHashesKey = "written_hashes"
+
+    // environmentSecretsParallelism is the number of secrets written at the same time.
+    environmentSecretsParallelism = 8
+)
+
+// NewEnvironmentSecretsResource is a helper function to simplify the provider implementation.
+func NewEnvironmentSecretsResource() resource.Resource {
+    return &environmentSecretsResource{}
+}
+
+// environmentSecretsResource is the resource implementation.
+type environmentSecretsResource struct {
+    client *client.Client
+}
+
+// environmentSecretsResourceModel maps the resource schema data.
+type environmentSecretsResourceModel struct {
+    ID        types.String `tfsdk:"id"`
+    ProjectID types.String `tfsdk:"project_id"`
+    Secrets   types.Map    `tfsdk:"secrets"`
+    Exclusive types.Bool   `tfsdk:"exclusive"`
+    Hashes    types.Map    `tfsdk:"hashes"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *environmentSecretsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *environmentSecretsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_environment_secrets"
+}
+
+// Schema defines the schema for the resource.
+func (r *environmentSecretsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages the environment secrets of a project as a whole.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the project, as the secrets of a project are managed by a single resource.",
+                Computed:    true,
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "secrets": schema.MapAttribute{
+                Description: "Values of the environment secrets, by key.",
+                ElementType: types.StringType,
+                Required:    true,
+                Sensitive:   true,
+                Validators: []validator.Map{
+                    mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+                    mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+                },
+            },
+            "exclusive": schema.BoolAttribute{
+                Description: "Delete the secrets of the project that are not in secrets, including secrets created outside of Terraform. Default=false.",
+                Optional:    true,
+            },
+            "hashes": schema.MapAttribute{
+                Description: "Hashes of the environment secrets, by key. With exclusive, the secrets that are not in secrets are listed as well until they are deleted.",
+                ElementType: types.StringType,
+                Computed:    true,
+            },
+        },
+    }
+}
+
+// Create writes the secrets and sets the initial Terraform state. Secrets that already exist are updated. When some
+// secrets fail to be written, the secrets created are deleted again, but those updated or deleted can't be restored.
+func (r *environmentSecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan environmentSecretsResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    secrets := map[string]string{}
+    resp.Diagnostics.Append(plan.Secrets.ElementsAs(ctx, &secrets, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := plan.ProjectID.ValueString()
+    result, err := r.syncSecrets(ctx, projectID, nil, secrets, nil, plan.Exclusive.ValueBool())
+    if err != nil {
+        // Terraform would replace a resource created with errors, deleting all of its secrets, so nothing is saved
+        // and the secrets created are deleted instead. The next apply writes all the secrets again.
+        if result != nil {
+            for key, id := range result.created {
+                if deleteErr := r.client.DeleteEnvironmentSecret(ctx, projectID, id); deleteErr != nil && !errors.Is(deleteErr, client.ErrNotFound) {
+                    err = errors.Join(err, fmt.Errorf("%s: could not delete the created secret: %w", key, deleteErr))
+                }
+            }
+        }
+        resp.Diagnostics.AddError(
+            "Error creating environment secrets",
+            "Could not create environment secrets, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    plan.ID = plan.ProjectID
+    resp.Diagnostics.Append(result.setState(ctx, &plan, resp.Private)...)
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *environmentSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state environmentSecretsResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    secrets, err := r.client.GetEnvironmentSecrets(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error reading environment secrets",
+            "Could not read environment secrets, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    managed := map[string]string{}
+    if !state.Secrets.IsNull() {
+        resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &managed, false)...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+    }
+
+    // Imported secrets are all managed, and with exclusive the other secrets are listed to plan their deletion.
+    hashes := map[string]string{}
+    for _, secret := range secrets {
+        if _, ok := managed[secret.Key]; ok || state.Secrets.IsNull() || state.Exclusive.ValueBool() {
+            hashes[secret.Key] = secret.Hash
+        }
+    }
+    state.Hashes, diags = types.MapValueFrom(ctx, types.StringType, hashes)
+    resp.Diagnostics.Append(diags...)
+
+    // Imported secrets start from their current hashes
+    writtenHashes, diags := req.Private.GetKey(ctx, environmentSecretsHashesKey)
+    resp.Diagnostics.Append(diags...)
+    if writtenHashes == nil {
+        resp.Diagnostics.Append(setEnvironmentSecretsHashes(ctx, resp.Private, hashes)...)
+    }
+
+    // Set the refreshed state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan plans to write the secrets again when the secrets in Paragon no longer match the state: a secret was
+// deleted, changed outside of Terraform, i.e. its hash differs from the hash returned when it was written, or a
+// secret that is not managed must be deleted.
+func (r *environmentSecretsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var plan, state environmentSecretsResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() || plan.Secrets.IsUnknown() || plan.Hashes.IsUnknown() {
+        return
+    }
+
+    secrets := map[string]types.String{}
+    hashes := map[string]string{}
+    resp.Diagnostics.Append(plan.Secrets.ElementsAs(ctx, &secrets, false)...)
+    resp.Diagnostics.Append(state.Hashes.ElementsAs(ctx, &hashes, false)...)
+    writtenHashes, diags := getEnvironmentSecretsHashes(ctx, req.Private)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    outOfSync := false
+    for key := range secrets {
+        hash, ok := hashes[key]
+        if written, recorded := writtenHashes[key]; !ok || (recorded && hash != written) {
+            outOfSync = true
+        }
+    }
+    for key := range hashes {
+        if _, ok := secrets[key]; !ok {
+            outOfSync = true
+        }
+    }
+
+    if outOfSync {
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hashes"), types.MapUnknown(types.StringType))...)
+    }
+}
+
+// Update writes the secrets that changed and sets the updated Terraform state.
+func (r *environmentSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan, state environmentSecretsResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    prior := map[string]string{}
+    if !state.Secrets.IsNull() {
+        resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &prior, false)...)
+    }
+    secrets := map[string]string{}
+    resp.Diagnostics.Append(plan.Secrets.ElementsAs(ctx, &secrets, false)...)
+    writtenHashes, diags := getEnvironmentSecretsHashes(ctx, req.Private)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.syncSecrets(ctx, state.ProjectID.ValueString(), prior, secrets, writtenHashes, plan.Exclusive.ValueBool())
+    if result == nil {
+        resp.Diagnostics.AddError(
+            "Error updating environment secrets",
+            "Could not update environment secrets, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    plan.ID = state.ID
+    resp.Diagnostics.Append(result.setState(ctx, &plan, resp.Private)...)
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating environment secrets",
+            "Could not update environment secrets, unexpected error: "+err.Error(),
+        )
+    }
+}
+
+// Delete deletes the managed secrets and removes the Terraform state on success. Secrets that are not managed are
+// kept, even with exclusive.
+func (r *environmentSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state environmentSecretsResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    prior := map[string]string{}
+    resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &prior, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.syncSecrets(ctx, state.ProjectID.ValueString(), prior, nil, nil, false)
+    if err != nil && !errors.Is(err, client.ErrNotFound) {
+        resp.Diagnostics.AddError(
+            "Error deleting environment secrets",
+            "Could not delete environment secrets, unexpected error: "+err.Error(),
+        )
+    }
+}
+
+// ImportState imports the secrets of a project by its ID. Paragon never returns the values of secrets, so the
+// configured secrets are written on the next apply.
+func (r *environmentSecretsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+// environmentSecretsSync is the outcome of writing the secrets of a project.
+type environmentSecretsSync struct {
+    mu sync.Mutex
+    // values are the configured values of the secrets that are in Paragon.
+    values map[string]string
+    // hashes are the hashes Paragon returns, including those of secrets that failed to be deleted.
+    hashes map[string]string
+    // writtenHashes are the hashes returned when the values were written.
+    writtenHashes map[string]string
+    // created are the IDs of the secrets that were created, by key.
+    created map[string]string
+}
+
+func (s *environmentSecretsSync) set(key, value, hash, writtenHash string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.values[key] = value
+    s.hashes[key] = hash
+    s.writtenHashes[key] = writtenHash
+}
+
+// setState sets the secrets and hashes of the model, and the written hashes in the private state.
+func (s *environmentSecretsSync) setState(ctx context.Context, model *environmentSecretsResourceModel, private interface {
+    SetKey(context.Context, string, []byte) diag.Diagnostics
+}) diag.Diagnostics {
+    var diags diag.Diagnostics
+    var d diag.Diagnostics
+
+    model.Secrets, d = types.MapValueFrom(ctx, types.StringType, s.values)
+    diags.Append(d...)
+    model.Hashes, d = types.MapValueFrom(ctx, types.StringType, s.hashes)
+    diags.Append(d...)
+    diags.Append(setEnvironmentSecretsHashes(ctx, private, s.writtenHashes)...)
+    return diags
+}
+
+// syncSecrets writes the secrets of a project in parallel. Secrets missing from Paragon are created. Secrets whose
+// value changed from prior, or whose hash differs from the written hash, are updated. Secrets of prior that are no
+// longer configured are deleted, and so is any other secret with exclusive. Secrets that fail to be written keep
+// their prior value, so that they are written again on the next apply, and the secrets created are listed so that
+// Create can delete them again. The returned sync is nil when the secrets could not be listed.
+func (r *environmentSecretsResource) syncSecrets(ctx context.Context, projectID string, prior, secrets, writtenHashes map[string]string, exclusive bool) (*environmentSecretsSync, error) {
+    existing, err := r.client.GetEnvironmentSecrets(ctx, projectID)
+    if err != nil {
+        return nil, err
+    }
+
+    result := &environmentSecretsSync{
+        values:        map[string]string{},
+        hashes:        map[string]string{},
+        writtenHashes: map[string]string{},
+        created:       map[string]string{},
+    }
+    var errs []error
+    var errsMu sync.Mutex
+    fail := func(key string, err error) {
+        errsMu.Lock()
+        defer errsMu.Unlock()
+        errs = append(errs, fmt.Errorf("%s: %w", key, err))
+    }
+
+    // keep records a secret as it is in Paragon, e.g. when it failed to be written.
+    keep := func(secret client.EnvironmentSecret) {
+        if value, ok := prior[secret.Key]; ok {
+            result.set(secret.Key, value, secret.Hash, writtenHashes[secret.Key])
+        } else {
+            result.mu.Lock()
+            result.hashes[secret.Key] = secret.Hash
+            result.mu.Unlock()
+        }
+    }
+
+    group := new(errgroup.Group)
+    group.SetLimit(environmentSecretsParallelism)
+
+    existingKeys := make(map[string]bool, len(existing))
+    for _, secret := range existing {
+        secret := secret
+        existingKeys[secret.Key] = true
+        value, configured := secrets[secret.Key]
+        priorValue, managed := prior[secret.Key]
+
+        switch {
+        case !configured && (managed || exclusive):
+            group.Go(func() error {
+                if err := r.client.DeleteEnvironmentSecret(ctx, projectID, secret.ID); err != nil && !errors.Is(err, client.ErrNotFound) {
+                    fail(secret.Key, err)
+                    keep(secret)
+                }
+                return nil
+            })
+        case !configured:
+            // Not managed, the secret is left alone.
+        case !managed || priorValue != value || secret.Hash != writtenHashes[secret.Key]:
+            group.Go(func() error {
+                updated, err := r.client.UpdateEnvironmentSecret(ctx, projectID, secret.ID, secret.Key, value)
+                if err != nil {
+                    fail(secret.Key, err)
+                    keep(secret)
+                    return nil
+                }
+                result.set(secret.Key, value, updated.Hash, updated.Hash)
+                return nil
+            })
+        default:
+            result.set(secret.Key, value, secret.Hash, writtenHashes[secret.Key])
+        }
+    }
+
+    for key, value := range secrets {
+        if existingKeys[key] {
+            continue
+        }
+        key, value := key, value
+        group.Go(func() error {
+            created, err := r.client.CreateEnvironmentSecret(ctx, projectID, key, value)
+            if err != nil {
+                fail(key, err)
+                return nil
+            }
+            result.set(key, value, created.Hash, created.Hash)
+            result.mu.Lock()
+            result.created[key] = created.ID
+            result.mu.Unlock()
+            return nil
+        })
+    }
+
+    _ = group.Wait()
+    return result, errors.Join(errs...)
+}
+
+// getEnvironmentSecretsHashes returns the hashes recorded in the private state when the secrets were written.
+func getEnvironmentSecretsHashes(ctx context.Context, private interface {
+    GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}) (map[string]string, diag.Diagnostics) {
+    value, diags := private.GetKey(ctx, environmentSecretsHashesKey)
+    hashes := map[string]string{}
+    if value == nil || diags.HasError() {
+        return hashes, diags
+    }
+    if err := json.Unmarshal(value, &hashes); err != nil {
+        diags.AddError(
+            "Error reading environment secret hashes",
+            "Could not decode the hashes recorded in the private state: "+err.Error(),
+        )
+    }
+    return hashes, diags
+}
+
+// setEnvironmentSecretsHashes records the hashes returned when the secrets were written in the private state.
+func setEnvironmentSecretsHashes(ctx context.Context, private interface {
+    SetKey(context.Context, string, []byte) diag.Diagnostics
+}, hashes map[string]string) diag.Diagnostics {
+    value, _ := json.Marshal(hashes)
+    return private.SetKey(ctx, environmentSecretsHashesKey, value)
+}
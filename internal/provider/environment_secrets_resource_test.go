@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccEnvironmentSecretsResourceConfig(server *fakeparagon.Server, projectID string, exclusive bool, secrets map[string]string) string {
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var entries strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&entries, "    %s = %q\n", key, secrets[key])
+	}
+
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_environment_secrets" "test" {
+  project_id = %[1]q
+  exclusive  = %[2]t
+
+  secrets = {
+%[3]s  }
+}
+`, projectID, exclusive, entries.String())
+}
+
+// testAccSecretID returns the ID of a secret of the project, with a new client as clients reuse the lists they
+// fetched.
+func testAccSecretID(t *testing.T, server *fakeparagon.Server, projectID, key string) string {
+	t.Helper()
+
+	secrets, err := testAccClient(t, server).GetEnvironmentSecrets(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("listing environment secrets: %v", err)
+	}
+	for _, secret := range secrets {
+		if secret.Key == key {
+			return secret.ID
+		}
+	}
+	t.Fatalf("environment secret %s not found", key)
+	return ""
+}
+
+// testAccCheckNoSecret checks that the secret doesn't exist in the project.
+func testAccCheckNoSecret(server *fakeparagon.Server, projectID, key string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, ok := server.SecretValue(projectID, key); ok {
+			return fmt.Errorf("environment secret %s still exists", key)
+		}
+		return nil
+	}
+}
+
+func TestAccEnvironmentSecretsResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-environment-secrets")
+
+	// An unmanaged secret, and one the resource adopts.
+	for key, value := range map[string]string{"UNMANAGED": "unmanaged", "EXISTING": "before"} {
+		if _, err := c.CreateEnvironmentSecret(context.Background(), project.ID, key, value); err != nil {
+			t.Fatalf("creating environment secret: %v", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckNoSecret(server, project.ID, "FIRST"),
+			testAccCheckNoSecret(server, project.ID, "THIRD"),
+			testAccCheckNoSecret(server, project.ID, "EXISTING"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentSecretsResourceConfig(server, project.ID, false, map[string]string{
+					"FIRST": "first", "SECOND": "second", "EXISTING": "after",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_environment_secrets.test", "id", project.ID),
+					resource.TestCheckResourceAttr("paragon_environment_secrets.test", "hashes.%", "3"),
+					resource.TestCheckResourceAttrSet("paragon_environment_secrets.test", "hashes.FIRST"),
+					testAccCheckSecretValue(server, project.ID, "FIRST", "first"),
+					testAccCheckSecretValue(server, project.ID, "SECOND", "second"),
+					testAccCheckSecretValue(server, project.ID, "EXISTING", "after"),
+					testAccCheckSecretValue(server, project.ID, "UNMANAGED", "unmanaged"),
+				),
+			},
+			{
+				Config: testAccEnvironmentSecretsResourceConfig(server, project.ID, false, map[string]string{
+					"FIRST": "first-updated", "THIRD": "third", "EXISTING": "after",
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secrets.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretValue(server, project.ID, "FIRST", "first-updated"),
+					testAccCheckSecretValue(server, project.ID, "THIRD", "third"),
+					testAccCheckNoSecret(server, project.ID, "SECOND"),
+					testAccCheckSecretValue(server, project.ID, "UNMANAGED", "unmanaged"),
+				),
+			},
+			{
+				// Secrets changed or deleted outside of Terraform are written again.
+				PreConfig: func() {
+					if _, err := c.UpdateEnvironmentSecret(context.Background(), project.ID, testAccSecretID(t, server, project.ID, "FIRST"), "FIRST", "changed"); err != nil {
+						t.Fatalf("updating environment secret: %v", err)
+					}
+					if err := c.DeleteEnvironmentSecret(context.Background(), project.ID, testAccSecretID(t, server, project.ID, "THIRD")); err != nil {
+						t.Fatalf("deleting environment secret: %v", err)
+					}
+				},
+				Config: testAccEnvironmentSecretsResourceConfig(server, project.ID, false, map[string]string{
+					"FIRST": "first-updated", "THIRD": "third", "EXISTING": "after",
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secrets.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretValue(server, project.ID, "FIRST", "first-updated"),
+					testAccCheckSecretValue(server, project.ID, "THIRD", "third"),
+				),
+			},
+			{
+				// Exclusive deletes the secrets that are not managed.
+				Config: testAccEnvironmentSecretsResourceConfig(server, project.ID, true, map[string]string{
+					"FIRST": "first-updated", "THIRD": "third", "EXISTING": "after",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNoSecret(server, project.ID, "UNMANAGED"),
+					resource.TestCheckResourceAttr("paragon_environment_secrets.test", "hashes.%", "3"),
+				),
+			},
+			{
+				// Including secrets created outside of Terraform later on.
+				PreConfig: func() {
+					if _, err := c.CreateEnvironmentSecret(context.Background(), project.ID, "ROGUE", "rogue"); err != nil {
+						t.Fatalf("creating environment secret: %v", err)
+					}
+				},
+				Config: testAccEnvironmentSecretsResourceConfig(server, project.ID, true, map[string]string{
+					"FIRST": "first-updated", "THIRD": "third", "EXISTING": "after",
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secrets.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckNoSecret(server, project.ID, "ROGUE"),
+			},
+			{
+				// Paragon never returns the values of secrets.
+				ResourceName:            "paragon_environment_secrets.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets", "exclusive"},
+			},
+		},
+	})
+}
+
+func TestAccEnvironmentSecretsResource_createPartialFailure(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-environment-secrets-partial-failure")
+
+	if _, err := c.CreateEnvironmentSecret(context.Background(), project.ID, "EXISTING", "before"); err != nil {
+		t.Fatalf("creating environment secret: %v", err)
+	}
+
+	config := testAccEnvironmentSecretsResourceConfig(server, project.ID, false, map[string]string{
+		"FIRST": "first", "SECOND": "second", "EXISTING": "after",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// One of the secrets fails to be created.
+				PreConfig: func() {
+					server.FailNext(http.MethodPost, "/projects/"+project.ID+"/secrets", http.StatusBadRequest, 1, "")
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Could not create environment secrets`),
+			},
+			{
+				// The secret created along with it was deleted and nothing was saved, so the resource is created
+				// again rather than replaced. The existing secret keeps the value it was updated with.
+				PreConfig: func() {
+					for _, key := range []string{"FIRST", "SECOND"} {
+						if _, ok := server.SecretValue(project.ID, key); ok {
+							t.Errorf("expected environment secret %s to be deleted", key)
+						}
+					}
+					if value, _ := server.SecretValue(project.ID, "EXISTING"); value != "after" {
+						t.Errorf("expected the existing secret to be updated, got %q", value)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_environment_secrets.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretValue(server, project.ID, "FIRST", "first"),
+					testAccCheckSecretValue(server, project.ID, "SECOND", "second"),
+					testAccCheckSecretValue(server, project.ID, "EXISTING", "after"),
+				),
+			},
+		},
+	})
+}
@@ -4,9 +4,10 @@ package provider
 
 import (
     "context"
+    "errors"
     "regexp"
-    "strings"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
     "github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +22,7 @@ import (
 var (
     _ resource.Resource              = &eventsDestinationResource{}
     _ resource.ResourceWithConfigure = &eventsDestinationResource{}
+    _ resource.ResourceWithImportState = &eventsDestinationResource{}
 )
 
 // NewEventsDestinationResource is a helper function to simplify the provider implementation.
@@ -240,7 +242,7 @@ func (r *eventsDestinationResource) Read(ctx context.Context, req resource.ReadR
    eventDestination, err := r.client.GetEventDestination(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
    if err != nil {
        // Check if the error indicates a 404 status code
-       if strings.Contains(err.Error(), "status code: 404") {
+       if errors.Is(err, client.ErrNotFound) {
            // If the event destination is not found, remove the resource to trigger recreation
            resp.State.RemoveResource(ctx)
            return
@@ -399,11 +401,22 @@ func (r *eventsDestinationResource) Delete(ctx context.Context, req resource.Del
 
    // Delete the events destination
    err := r.client.DeleteEventDestination(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
-   if err != nil {
+   if err != nil && !errors.Is(err, client.ErrNotFound) {
        resp.Diagnostics.AddError(
            "Error deleting event destination",
            err.Error(),
        )
        return
    }
-}
\ No newline at end of file
+}
+
+// ImportState imports an existing events destination by "project_id/destination_id".
+func (r *eventsDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, destinationID, ok := splitImportID(req, resp, "project_id/destination_id")
+    if !ok {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), destinationID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccEventsDestinationEmailConfig(server *fakeparagon.Server, projectID string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_events_destination" "test" {
+  project_id = %[1]q
+  events     = ["integration_enabled"]
+
+  email = {
+    address = "alerts@example.com"
+  }
+}
+`, projectID)
+}
+
+func testAccEventsDestinationWebhookConfig(server *fakeparagon.Server, projectID string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_events_destination" "test" {
+  project_id = %[1]q
+  events     = ["integration_enabled", "integration_disabled"]
+
+  webhook = {
+    url  = "https://hooks.example.com/paragon"
+    body = "{\"event\": \"{{$.event}}\", \"user\": \"{{$.user.id}}\"}"
+    headers = {
+      Authorization = "Bearer secret"
+    }
+  }
+}
+`, projectID)
+}
+
+func TestAccEventsDestinationResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-events-destination")
+
+	var destinationID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_events_destination", func(attributes map[string]string) error {
+			_, err := c.GetEventDestination(context.Background(), attributes["project_id"], attributes["id"])
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDestinationEmailConfig(server, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_events_destination.test", "email.address", "alerts@example.com"),
+					resource.TestCheckResourceAttr("paragon_events_destination.test", "events.#", "1"),
+					resource.TestCheckNoResourceAttr("paragon_events_destination.test", "webhook"),
+					testAccStateAttr("paragon_events_destination.test", "id", &destinationID),
+				),
+			},
+			{
+				Config: testAccEventsDestinationWebhookConfig(server, project.ID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_events_destination.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("paragon_events_destination.test", "id", &destinationID),
+					resource.TestCheckResourceAttr("paragon_events_destination.test", "webhook.url", "https://hooks.example.com/paragon"),
+					resource.TestCheckResourceAttr("paragon_events_destination.test", "webhook.body", `{"event": "{{$.event}}", "user": "{{$.user.id}}"}`),
+					resource.TestCheckResourceAttr("paragon_events_destination.test", "webhook.headers.Authorization", "Bearer secret"),
+					resource.TestCheckResourceAttr("paragon_events_destination.test", "events.#", "2"),
+					resource.TestCheckNoResourceAttr("paragon_events_destination.test", "email"),
+				),
+			},
+			{
+				ResourceName:      "paragon_events_destination.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateID("paragon_events_destination.test", "project_id", "id"),
+			},
+			{
+				PreConfig: func() {
+					if err := c.DeleteEventDestination(context.Background(), project.ID, destinationID); err != nil {
+						t.Fatalf("deleting event destination: %v", err)
+					}
+				},
+				Config: testAccEventsDestinationWebhookConfig(server, project.ID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_events_destination.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
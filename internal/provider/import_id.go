@@ -0,0 +1,50 @@
+// import_id.go
+package provider
+
+import (
+    "context"
+    "fmt"
+    "strings"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// splitImportID splits an import ID of the form "<parent>/<child>", e.g. "project_id/key". The child may
+// contain slashes itself. An error describing the expected format is added when the ID doesn't match it.
+func splitImportID(req resource.ImportStateRequest, resp *resource.ImportStateResponse, format string) (string, string, bool) {
+    parent, child, ok := strings.Cut(req.ID, "/")
+    if !ok || parent == "" || child == "" {
+        resp.Diagnostics.AddError(
+            "Invalid import ID",
+            fmt.Sprintf("Expected an import ID of the form %q, got %q.", format, req.ID),
+        )
+        return "", "", false
+    }
+    return parent, child, true
+}
+
+// importIntegrationID resolves the integration part of an import ID, which is either the identifier of the
+// integration or its type, e.g. "salesforce", or the slug of a custom integration.
+func importIntegrationID(ctx context.Context, c *client.Client, projectID, integration string, resp *resource.ImportStateResponse) (string, bool) {
+    integrations, err := c.GetIntegrations(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integrations",
+            "Could not read integrations, unexpected error: "+err.Error(),
+        )
+        return "", false
+    }
+
+    for _, i := range integrations {
+        if i.ID == integration || (i.Type == integration && i.Type != "custom") || (i.CustomIntegration != nil && i.CustomIntegration.Slug == integration) {
+            return i.ID, true
+        }
+    }
+
+    resp.Diagnostics.AddError(
+        "Integration not found",
+        fmt.Sprintf("No integration '%s' exists in project '%s'", integration, projectID),
+    )
+    return "", false
+}
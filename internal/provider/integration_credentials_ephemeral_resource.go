@@ -0,0 +1,189 @@
+// integration_credentials_ephemeral_resource.go
+package provider
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+    "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ ephemeral.EphemeralResource              = &integrationCredentialsEphemeralResource{}
+    _ ephemeral.EphemeralResourceWithConfigure = &integrationCredentialsEphemeralResource{}
+)
+
+// NewIntegrationCredentialsEphemeralResource is a helper function to simplify the provider implementation.
+func NewIntegrationCredentialsEphemeralResource() ephemeral.EphemeralResource {
+    return &integrationCredentialsEphemeralResource{}
+}
+
+// integrationCredentialsEphemeralResource is the ephemeral resource implementation.
+type integrationCredentialsEphemeralResource struct {
+    client *client.Client
+}
+
+// integrationCredentialsEphemeralResourceModel maps the ephemeral resource schema data.
+type integrationCredentialsEphemeralResourceModel struct {
+    ProjectID     types.String  `tfsdk:"project_id"`
+    CredentialID  types.String  `tfsdk:"credential_id"`
+    IntegrationID types.String  `tfsdk:"integration_id"`
+    Scheme        types.String  `tfsdk:"scheme"`
+    Provider      types.String  `tfsdk:"creds_provider"`
+    Status        types.String  `tfsdk:"status"`
+    Values        types.Dynamic `tfsdk:"values"`
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *integrationCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    e.client = client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *integrationCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration_credentials"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *integrationCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the decrypted values of integration credentials, without storing them in the plan or the state.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+            },
+            "credential_id": schema.StringAttribute{
+                Description: "Identifier of the integration credentials. Either credential_id or integration_id must be set.",
+                Optional:    true,
+                Computed:    true,
+                Validators: []validator.String{
+                    stringvalidator.ExactlyOneOf(path.MatchRoot("integration_id")),
+                },
+            },
+            "integration_id": schema.StringAttribute{
+                Description: "Identifier of the integration, which must have a single set of credentials.",
+                Optional:    true,
+                Computed:    true,
+            },
+            "scheme": schema.StringAttribute{
+                Description: "Scheme of the integration credentials.",
+                Computed:    true,
+            },
+            "creds_provider": schema.StringAttribute{
+                Description: "Provider of the integration credentials.",
+                Computed:    true,
+            },
+            "status": schema.StringAttribute{
+                Description: "Status of the integration credentials.",
+                Computed:    true,
+            },
+            "values": schema.DynamicAttribute{
+                Description: "Decrypted values of the integration credentials, e.g. clientId and clientSecret for OAuth apps.",
+                Computed:    true,
+                Sensitive:   true,
+            },
+        },
+    }
+}
+
+// Open fetches the decrypted credentials.
+func (e *integrationCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+    var data integrationCredentialsEphemeralResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := data.ProjectID.ValueString()
+    credentialID := data.CredentialID.ValueString()
+
+    if data.CredentialID.IsNull() {
+        integrationID := data.IntegrationID.ValueString()
+
+        credentials, err := e.client.GetCredentials(ctx, projectID)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Integration Credentials",
+                err.Error(),
+            )
+            return
+        }
+
+        var matchingIDs []string
+        for _, credential := range credentials {
+            if credential.IntegrationID == integrationID {
+                matchingIDs = append(matchingIDs, credential.ID)
+            }
+        }
+
+        if len(matchingIDs) == 0 {
+            resp.Diagnostics.AddError(
+                "Integration Credentials Not Found",
+                fmt.Sprintf("Integration '%s' of project '%s' has no credentials", integrationID, projectID),
+            )
+            return
+        }
+        if len(matchingIDs) > 1 {
+            resp.Diagnostics.AddError(
+                "Multiple Integration Credentials Found",
+                fmt.Sprintf("Integration '%s' of project '%s' has %d credentials (%s), set credential_id instead of integration_id to choose one.",
+                    integrationID, projectID, len(matchingIDs), strings.Join(matchingIDs, ", ")),
+            )
+            return
+        }
+        credentialID = matchingIDs[0]
+    }
+
+    credential, err := e.client.GetDecryptedCredential(ctx, projectID, credentialID)
+    if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.Diagnostics.AddError(
+                "Integration Credentials Not Found",
+                fmt.Sprintf("Credentials '%s' not found in project '%s'", credentialID, projectID),
+            )
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Unable to Read Integration Credentials",
+            err.Error(),
+        )
+        return
+    }
+
+    values, err := jsonToValue(credential.Values)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Integration Credentials",
+            "Could not convert the credential values: "+err.Error(),
+        )
+        return
+    }
+
+    data.CredentialID = types.StringValue(credential.ID)
+    data.IntegrationID = types.StringValue(credential.IntegrationID)
+    data.Scheme = types.StringValue(credential.Scheme)
+    data.Provider = types.StringValue(credential.Provider)
+    data.Status = types.StringValue(credential.Status)
+    data.Values = types.DynamicValue(values)
+
+    // Set the result, which Terraform never stores
+    resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
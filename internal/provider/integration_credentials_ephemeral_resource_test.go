@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// testAccIntegrationCredentialsEphemeralResourceConfig opens the ephemeral resource and echoes the given
+// attribute of its result into the state of an echo resource named after it, as ephemeral values can't be
+// checked otherwise.
+func testAccIntegrationCredentialsEphemeralResourceConfig(arguments, attribute string) string {
+	return fmt.Sprintf(`
+ephemeral "paragon_integration_credentials" "test" {
+%[1]s
+}
+
+provider "echo" {
+  data = ephemeral.paragon_integration_credentials.test.%[2]s
+}
+
+resource "echo" %[2]q {}
+`, arguments, attribute)
+}
+
+func TestAccIntegrationCredentialsEphemeralResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-integration-credentials-ephemeral")
+	integration := server.AddIntegration(project.ID, "salesforce")
+	other := server.AddIntegration(project.ID, "hubspot")
+
+	credential, err := c.CreateIntegrationCredentials(context.Background(), project.ID, client.CreateIntegrationCredentialsRequest{
+		Name:          "salesforce",
+		Provider:      "salesforce",
+		Scheme:        "oauth_app",
+		IntegrationID: integration.ID,
+		Values: client.OAuthValues{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			Scopes:       "read write",
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating credentials: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"paragon": testAccProtoV6ProviderFactories["paragon"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccIntegrationCredentialsEphemeralResourceConfig(fmt.Sprintf(`
+  project_id     = %[1]q
+  integration_id = %[2]q
+  credential_id  = %[3]q
+`, project.ID, integration.ID, credential.ID), "values"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccIntegrationCredentialsEphemeralResourceConfig(fmt.Sprintf(`
+  project_id     = %[1]q
+  integration_id = %[2]q
+`, project.ID, other.ID), "values"),
+				ExpectError: regexp.MustCompile(`Integration Credentials Not Found`),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccIntegrationCredentialsEphemeralResourceConfig(fmt.Sprintf(`
+  project_id    = %[1]q
+  credential_id = "missing"
+`, project.ID), "values"),
+				ExpectError: regexp.MustCompile(`Integration Credentials Not Found`),
+			},
+			{
+				// The credentials are found from the integration.
+				Config: testAccProviderConfig(server) + testAccIntegrationCredentialsEphemeralResourceConfig(fmt.Sprintf(`
+  project_id     = %[1]q
+  integration_id = %[2]q
+`, project.ID, integration.ID), "values"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.values", tfjsonpath.New("data"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"clientId":     knownvalue.StringExact("client-id"),
+						"clientSecret": knownvalue.StringExact("client-secret"),
+						"scopes":       knownvalue.StringExact("read write"),
+					})),
+				},
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccIntegrationCredentialsEphemeralResourceConfig(fmt.Sprintf(`
+  project_id    = %[1]q
+  credential_id = %[2]q
+`, project.ID, credential.ID), "integration_id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.integration_id", tfjsonpath.New("data"), knownvalue.StringExact(integration.ID)),
+				},
+			},
+		},
+	})
+}
@@ -2,8 +2,12 @@ package provider
 
 import (
     "context"
+    "encoding/json"
+    "errors"
+    "fmt"
     "strings"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
     "github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,7 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
     "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
     "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+    "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+    "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
     "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
 
 )
 
@@ -21,6 +28,8 @@ import (
 var (
     _ resource.Resource              = &integrationCredentialsResource{}
     _ resource.ResourceWithConfigure = &integrationCredentialsResource{}
+    _ resource.ResourceWithImportState = &integrationCredentialsResource{}
+    _ resource.ResourceWithModifyPlan  = &integrationCredentialsResource{}
 )
 
 // NewIntegrationCredentialsResource is a helper function to simplify the provider implementation.
@@ -40,7 +49,10 @@ type integrationCredentialsResourceModel struct {
     IntegrationID types.String `tfsdk:"integration_id"`
     Scheme        types.String `tfsdk:"scheme"`
     Provider      types.String `tfsdk:"creds_provider"`
-    OAuth         *oauthModel  `tfsdk:"oauth"`
+    OAuth         *oauthModel             `tfsdk:"oauth"`
+    APIKey        *apiKeyCredentialsModel `tfsdk:"api_key"`
+    Basic         *basicCredentialsModel  `tfsdk:"basic"`
+    Custom        types.Map               `tfsdk:"custom"`
 }
 
 type oauthModel struct {
@@ -49,6 +61,63 @@ type oauthModel struct {
     Scopes       types.List   `tfsdk:"scopes"`
 }
 
+type apiKeyCredentialsModel struct {
+    Value types.String `tfsdk:"value"`
+}
+
+type basicCredentialsModel struct {
+    Username types.String `tfsdk:"username"`
+    Password types.String `tfsdk:"password"`
+}
+
+// scheme returns the scheme of the credentials configured by the model.
+func (m integrationCredentialsResourceModel) scheme() string {
+    switch {
+    case m.OAuth != nil:
+        return client.CredentialSchemeOAuthApp
+    case m.APIKey != nil:
+        return client.CredentialSchemeAPIKey
+    case m.Basic != nil:
+        return client.CredentialSchemeBasic
+    default:
+        return client.CredentialSchemeCustom
+    }
+}
+
+// customIntegrationCredentialsSchemes maps the authentication types of custom integrations to the scheme of
+// their credentials.
+var customIntegrationCredentialsSchemes = map[string]string{
+    client.CustomIntegrationAuthOAuth:  client.CredentialSchemeOAuthApp,
+    client.CustomIntegrationAuthAPIKey: client.CredentialSchemeAPIKey,
+    client.CustomIntegrationAuthBasic:  client.CredentialSchemeBasic,
+}
+
+// credentialsSchemeBlocks maps the credential schemes to the block configuring them.
+var credentialsSchemeBlocks = map[string]string{
+    client.CredentialSchemeOAuthApp: "oauth",
+    client.CredentialSchemeAPIKey:   "api_key",
+    client.CredentialSchemeBasic:    "basic",
+    client.CredentialSchemeCustom:   "custom",
+}
+
+// defaultIntegrationCredentialsName names the credentials when the logged in user is unknown.
+const defaultIntegrationCredentialsName = "terraform"
+
+// integrationCredentialsName names the credentials after the logged in user, by email or else by ID. CLI keys
+// carry no claims, so credentials written with one get a fixed name instead.
+func integrationCredentialsName(c *client.Client) string {
+    claims, err := c.TokenClaims()
+    switch {
+    case err != nil:
+        return defaultIntegrationCredentialsName
+    case claims.Email != "":
+        return claims.Email
+    case claims.UserID != "":
+        return claims.UserID
+    }
+    return defaultIntegrationCredentialsName
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *integrationCredentialsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
     if req.ProviderData == nil {
@@ -87,17 +156,21 @@ func (r *integrationCredentialsResource) Schema(_ context.Context, _ resource.Sc
                 },
             },
             "scheme": schema.StringAttribute{
-                Description: "Scheme of the integration credentials.",
+                Description: "Scheme of the integration credentials, set by the block configuring them. Changing it replaces the credentials.",
                 Computed:    true,
             },
             "creds_provider": schema.StringAttribute{
                 Description: "Provider of the integration credentials.",
                 Computed:    true,
             },
+            // Exactly one of oauth, api_key, basic and custom is set, see ModifyPlan for changes between them.
             "oauth": schema.SingleNestedAttribute{
-                Description: "OAuth configuration for the integration credentials.",
-                Required:    true,
+                Description: "OAuth configuration for the integration credentials. Exactly one of oauth, api_key, basic or custom must be set.",
+                Optional:    true,
                 Sensitive:   true,
+                Validators: []validator.Object{
+                    objectvalidator.ExactlyOneOf(path.MatchRoot("api_key"), path.MatchRoot("basic"), path.MatchRoot("custom")),
+                },
                 Attributes: map[string]schema.Attribute{
                     "client_id": schema.StringAttribute{
                         Description: "Client ID for OAuth.",
@@ -127,10 +200,146 @@ func (r *integrationCredentialsResource) Schema(_ context.Context, _ resource.Sc
                     },
                 },
             },
+            "api_key": schema.SingleNestedAttribute{
+                Description: "API key of the integration credentials.",
+                Optional:    true,
+                Sensitive:   true,
+                Attributes: map[string]schema.Attribute{
+                    "value": schema.StringAttribute{
+                        Description: "The API key.",
+                        Required:    true,
+                        Sensitive:   true,
+                        Validators: []validator.String{
+                            stringvalidator.LengthAtLeast(1),
+                        },
+                    },
+                },
+            },
+            "basic": schema.SingleNestedAttribute{
+                Description: "Username and password of the integration credentials, for HTTP basic authentication.",
+                Optional:    true,
+                Sensitive:   true,
+                Attributes: map[string]schema.Attribute{
+                    "username": schema.StringAttribute{
+                        Description: "Username for basic authentication.",
+                        Required:    true,
+                        Sensitive:   true,
+                        Validators: []validator.String{
+                            stringvalidator.LengthAtLeast(1),
+                        },
+                    },
+                    "password": schema.StringAttribute{
+                        Description: "Password for basic authentication.",
+                        Required:    true,
+                        Sensitive:   true,
+                        Validators: []validator.String{
+                            stringvalidator.LengthAtLeast(1),
+                        },
+                    },
+                },
+            },
+            "custom": schema.MapAttribute{
+                Description: "Free-form values of the integration credentials, sent as is, for integrations with other schemes.",
+                ElementType: types.StringType,
+                Optional:    true,
+                Sensitive:   true,
+                Validators: []validator.Map{
+                    mapvalidator.SizeAtLeast(1),
+                },
+            },
         },
     }
 }
 
+// ModifyPlan plans the scheme from the block configuring the credentials. Credentials can't change scheme, as
+// each scheme is written to its own endpoint, so they are replaced when another block is set.
+func (r *integrationCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to plan when the credentials are destroyed.
+    if req.Plan.Raw.IsNull() {
+        return
+    }
+
+    scheme := ""
+    for blockScheme, block := range credentialsSchemeBlocks {
+        var value attr.Value
+        if blockScheme == client.CredentialSchemeCustom {
+            var custom types.Map
+            resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(block), &custom)...)
+            value = custom
+        } else {
+            var object types.Object
+            resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(block), &object)...)
+            value = object
+        }
+        if resp.Diagnostics.HasError() {
+            return
+        }
+
+        if value.IsUnknown() {
+            // The scheme is only known once the block is.
+            return
+        }
+        if !value.IsNull() {
+            scheme = blockScheme
+        }
+    }
+    if scheme == "" {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scheme"), scheme)...)
+
+    if req.State.Raw.IsNull() {
+        return
+    }
+
+    var state integrationCredentialsResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if state.Scheme.ValueString() != scheme {
+        resp.RequiresReplace = append(resp.RequiresReplace, path.Root("scheme"))
+        return
+    }
+
+    // The credentials are updated in place and keep their identifier and provider.
+    resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), state.ID)...)
+    resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("creds_provider"), state.Provider)...)
+}
+
+// integrationCredentialsValues returns the values of the credentials configured by the plan, in the payload of
+// their scheme.
+func integrationCredentialsValues(ctx context.Context, plan integrationCredentialsResourceModel) (interface{}, diag.Diagnostics) {
+    var diags diag.Diagnostics
+
+    switch {
+    case plan.OAuth != nil:
+        // Extract the scopes
+        var scopes []string
+        diags.Append(plan.OAuth.Scopes.ElementsAs(ctx, &scopes, false)...)
+        return client.OAuthValues{
+            ClientID:     plan.OAuth.ClientID.ValueString(),
+            ClientSecret: plan.OAuth.ClientSecret.ValueString(),
+            Scopes:       strings.Join(scopes, " "),
+        }, diags
+    case plan.APIKey != nil:
+        return client.APIKeyValues{
+            APIKey: plan.APIKey.Value.ValueString(),
+        }, diags
+    case plan.Basic != nil:
+        return client.BasicValues{
+            Username: plan.Basic.Username.ValueString(),
+            Password: plan.Basic.Password.ValueString(),
+        }, diags
+    default:
+        values := make(map[string]string, len(plan.Custom.Elements()))
+        diags.Append(plan.Custom.ElementsAs(ctx, &values, false)...)
+        return values, diags
+    }
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *integrationCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan integrationCredentialsResourceModel
@@ -153,45 +362,32 @@ func (r *integrationCredentialsResource) Create(ctx context.Context, req resourc
         return
     }
 
-    if integration.Type == "custom" {
-        if plan.OAuth != nil && integration.CustomIntegration.AuthenticationType != "oauth" {
+    // Custom integrations take the credentials of their authentication type, or free-form custom credentials
+    scheme := plan.scheme()
+    if integration.Type == "custom" && integration.CustomIntegration != nil && scheme != client.CredentialSchemeCustom {
+        authenticationType := integration.CustomIntegration.AuthenticationType
+        if customIntegrationCredentialsSchemes[authenticationType] != scheme {
             resp.Diagnostics.AddError(
                 "Invalid authentication type",
-                "The 'oauth' block is specified, but the custom integration's authentication type is not 'oauth'",
+                fmt.Sprintf("The '%s' block is specified, but the custom integration's authentication type is '%s'",
+                    credentialsSchemeBlocks[scheme], authenticationType),
             )
             return
         }
     }
 
-    // Extract the user email from the access token
-    email, err := r.client.GetUserEmailFromToken()
-    if err != nil {
-        resp.Diagnostics.AddError(
-            "Error extracting user email from access token",
-            "Could not extract user email from access token, unexpected error: "+err.Error(),
-        )
-        return
-    }
-
-    // Extract the scopes
-    var scopes []string
-    diags = plan.OAuth.Scopes.ElementsAs(ctx, &scopes, false)
+    values, diags := integrationCredentialsValues(ctx, plan)
     resp.Diagnostics.Append(diags...)
     if resp.Diagnostics.HasError() {
         return
     }
-    scopesStr := strings.Join(scopes, " ")
 
     // Create the integration credentials
     createCredReq := client.CreateIntegrationCredentialsRequest{
-        Name: email,
-        Values: client.OAuthValues{
-            ClientID:     plan.OAuth.ClientID.ValueString(),
-            ClientSecret: plan.OAuth.ClientSecret.ValueString(),
-            Scopes:       scopesStr,
-        },
+        Name:          integrationCredentialsName(r.client),
+        Values:        values,
         Provider:      integration.Type,
-        Scheme:        "oauth_app", // Currently only oauth_app creds are supported
+        Scheme:        scheme,
         IntegrationID: integrationID,
     }
 
@@ -232,7 +428,7 @@ func (r *integrationCredentialsResource) Read(ctx context.Context, req resource.
     // Retrieve the decrypted credential
     credential, err := r.client.GetDecryptedCredential(ctx, projectID, credID)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.State.RemoveResource(ctx)
             return
         }
@@ -248,32 +444,83 @@ func (r *integrationCredentialsResource) Read(ctx context.Context, req resource.
     state.Scheme = types.StringValue(credential.Scheme)
     state.Provider = types.StringValue(credential.Provider)
 
+    // Update the block of the scheme from the decrypted credential values
+    state.OAuth = nil
+    state.APIKey = nil
+    state.Basic = nil
+    state.Custom = types.MapNull(types.StringType)
+    switch credential.Scheme {
+    case client.CredentialSchemeOAuthApp:
+        state.OAuth = readOAuthValues(credential.Values, &resp.Diagnostics)
+    case client.CredentialSchemeAPIKey:
+        apiKey, ok := credential.Values["apiKey"].(string)
+        if !ok {
+            resp.Diagnostics.AddError(
+                "Error extracting API key",
+                "Could not extract API key from the decrypted credential values",
+            )
+            return
+        }
+        state.APIKey = &apiKeyCredentialsModel{
+            Value: types.StringValue(apiKey),
+        }
+    case client.CredentialSchemeBasic:
+        username, usernameOK := credential.Values["username"].(string)
+        password, passwordOK := credential.Values["password"].(string)
+        if !usernameOK || !passwordOK {
+            resp.Diagnostics.AddError(
+                "Error extracting username and password",
+                "Could not extract username and password from the decrypted credential values",
+            )
+            return
+        }
+        state.Basic = &basicCredentialsModel{
+            Username: types.StringValue(username),
+            Password: types.StringValue(password),
+        }
+    default:
+        state.Custom = readCustomValues(credential.Values, &resp.Diagnostics)
+    }
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// readOAuthValues reads the oauth block from the decrypted values of OAuth app credentials.
+func readOAuthValues(values map[string]interface{}, diags *diag.Diagnostics) *oauthModel {
     // Extract the client ID and secret from the decrypted credential values
-    clientID, ok := credential.Values["clientId"].(string)
+    clientID, ok := values["clientId"].(string)
     if !ok {
-        resp.Diagnostics.AddError(
+        diags.AddError(
             "Error extracting client ID",
             "Could not extract client ID from the decrypted credential values",
         )
-        return
+        return nil
     }
 
-    clientSecret, ok := credential.Values["clientSecret"].(string)
+    clientSecret, ok := values["clientSecret"].(string)
     if !ok {
-        resp.Diagnostics.AddError(
+        diags.AddError(
             "Error extracting client secret",
             "Could not extract client secret from the decrypted credential values",
         )
-        return
+        return nil
     }
 
-    scopesStr, ok := credential.Values["scopes"].(string)
+    scopesStr, ok := values["scopes"].(string)
     if !ok {
-        resp.Diagnostics.AddError(
+        diags.AddError(
             "Error extracting scopes",
             "Could not extract scopes from the decrypted credential values",
         )
-        return
+        return nil
     }
 
     scopesArr := strings.Split(scopesStr, " ")
@@ -282,19 +529,34 @@ func (r *integrationCredentialsResource) Read(ctx context.Context, req resource.
         scopesAttr = append(scopesAttr, types.StringValue(scope))
     }
 
-    // Update the OAuth block in the state
-    state.OAuth = &oauthModel{
+    return &oauthModel{
         ClientID:     types.StringValue(clientID),
         ClientSecret: types.StringValue(clientSecret),
         Scopes:       types.ListValueMust(types.StringType, scopesAttr),
     }
+}
 
-    // Set the refreshed state
-    diags = resp.State.Set(ctx, &state)
-    resp.Diagnostics.Append(diags...)
-    if resp.Diagnostics.HasError() {
-        return
+// readCustomValues reads the custom map from the decrypted values of credentials of any other scheme. Values
+// that are not strings are kept JSON encoded.
+func readCustomValues(values map[string]interface{}, diags *diag.Diagnostics) types.Map {
+    elements := make(map[string]attr.Value, len(values))
+    for key, value := range values {
+        if str, ok := value.(string); ok {
+            elements[key] = types.StringValue(str)
+            continue
+        }
+        encoded, err := json.Marshal(value)
+        if err != nil {
+            diags.AddError(
+                "Error extracting custom values",
+                fmt.Sprintf("Could not encode the decrypted credential value '%s': %s", key, err),
+            )
+            return types.MapNull(types.StringType)
+        }
+        elements[key] = types.StringValue(string(encoded))
     }
+
+    return types.MapValueMust(types.StringType, elements)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -326,33 +588,16 @@ func (r *integrationCredentialsResource) Update(ctx context.Context, req resourc
         return
     }
 
-    // Extract the user email from the access token
-    email, err := r.client.GetUserEmailFromToken()
-    if err != nil {
-        resp.Diagnostics.AddError(
-            "Error extracting user email from access token",
-            "Could not extract user email from access token, unexpected error: "+err.Error(),
-        )
-        return
-    }
-
-    // Extract the scopes
-    var scopes []string
-    diags = plan.OAuth.Scopes.ElementsAs(ctx, &scopes, false)
+    values, diags := integrationCredentialsValues(ctx, plan)
     resp.Diagnostics.Append(diags...)
     if resp.Diagnostics.HasError() {
         return
     }
-    scopesStr := strings.Join(scopes, " ")
 
-    // Update the integration credentials
+    // Update the integration credentials, the scheme is unchanged as changing it replaces them
     updateCredReq := client.CreateIntegrationCredentialsRequest{
-        Name:          email,
-        Values:        client.OAuthValues{
-            ClientID:     plan.OAuth.ClientID.ValueString(),
-            ClientSecret: plan.OAuth.ClientSecret.ValueString(),
-            Scopes:       scopesStr,
-        },
+        Name:          integrationCredentialsName(r.client),
+        Values:        values,
         Provider:      state.Provider.ValueString(),
         Scheme:        state.Scheme.ValueString(),
         IntegrationID: integrationID,
@@ -393,11 +638,48 @@ func (r *integrationCredentialsResource) Delete(ctx context.Context, req resourc
     credentialID := state.ID.ValueString()
 
     err := r.client.DeleteCredentials(ctx, projectID, credentialID)
-    if err != nil {
+    if err != nil && !errors.Is(err, client.ErrNotFound) {
         resp.Diagnostics.AddError(
             "Error deleting credentials",
             "Could not delete credentials, unexpected error: "+err.Error(),
         )
         return
     }
-}
\ No newline at end of file
+}
+
+// ImportState imports the credentials of an integration by "project_id/integration", where integration is the
+// identifier or the type of the integration.
+func (r *integrationCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, integration, ok := splitImportID(req, resp, "project_id/integration")
+    if !ok {
+        return
+    }
+
+    integrationID, ok := importIntegrationID(ctx, r.client, projectID, integration, resp)
+    if !ok {
+        return
+    }
+
+    credentials, err := r.client.GetCredentials(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading credentials",
+            "Could not read credentials, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    for _, credential := range credentials {
+        if credential.IntegrationID == integrationID {
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), credential.ID)...)
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), integrationID)...)
+            return
+        }
+    }
+
+    resp.Diagnostics.AddError(
+        "Integration credentials not found",
+        fmt.Sprintf("Integration '%s' of project '%s' has no credentials", integration, projectID),
+    )
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccIntegrationCredentialsResourceConfig(server *fakeparagon.Server, projectID, integrationID, clientSecret string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_integration_credentials" "test" {
+  project_id     = %[1]q
+  integration_id = %[2]q
+
+  oauth = {
+    client_id     = "client-id"
+    client_secret = %[3]q
+    scopes        = ["read", "write"]
+  }
+}
+`, projectID, integrationID, clientSecret)
+}
+
+func TestAccIntegrationCredentialsResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-integration-credentials")
+	integration := server.AddIntegration(project.ID, "salesforce")
+
+	var credentialID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_integration_credentials", func(attributes map[string]string) error {
+			_, err := c.GetDecryptedCredential(context.Background(), attributes["project_id"], attributes["id"])
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationCredentialsResourceConfig(server, project.ID, integration.ID, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "scheme", "oauth_app"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "creds_provider", "salesforce"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "oauth.client_secret", "first"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "oauth.scopes.#", "2"),
+					testAccCheckCredentialsName(t, server, project.ID, integration.ID, fakeparagon.DefaultEmail),
+					testAccStateAttr("paragon_integration_credentials.test", "id", &credentialID),
+				),
+			},
+			{
+				Config: testAccIntegrationCredentialsResourceConfig(server, project.ID, integration.ID, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_credentials.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("paragon_integration_credentials.test", "id", &credentialID),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "oauth.client_secret", "second"),
+				),
+			},
+			{
+				ResourceName:      "paragon_integration_credentials.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateID("paragon_integration_credentials.test", "project_id", "integration_id"),
+			},
+			{
+				PreConfig: func() {
+					if err := c.DeleteCredentials(context.Background(), project.ID, credentialID); err != nil {
+						t.Fatalf("deleting credentials: %v", err)
+					}
+				},
+				Config: testAccIntegrationCredentialsResourceConfig(server, project.ID, integration.ID, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_credentials.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckCredentialsName checks the name the credentials of the integration were written with.
+func testAccCheckCredentialsName(t *testing.T, server *fakeparagon.Server, projectID, integrationID, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		credentials, err := testAccClient(t, server).GetCredentials(context.Background(), projectID)
+		if err != nil {
+			return err
+		}
+		for _, credential := range credentials {
+			if credential.IntegrationID == integrationID {
+				if credential.Name != name {
+					return fmt.Errorf("expected the credentials to be named %q, got %q", name, credential.Name)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("credentials of integration %s not found", integrationID)
+	}
+}
+
+func TestAccIntegrationCredentialsResource_cliKey(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-integration-credentials-cli-key")
+	integration := server.AddIntegration(project.ID, "salesforce")
+
+	// CLI keys carry no claims, so the credentials are written with a fixed name rather than the user's email.
+	providerConfig := testAccProviderConfigCLIKey(t, server)
+	config := func(clientSecret string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "paragon_integration_credentials" "test" {
+  project_id     = %[1]q
+  integration_id = %[2]q
+
+  oauth = {
+    client_id     = "client-id"
+    client_secret = %[3]q
+    scopes        = ["read"]
+  }
+}
+`, project.ID, integration.ID, clientSecret)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "oauth.client_secret", "first"),
+					testAccCheckCredentialsName(t, server, project.ID, integration.ID, defaultIntegrationCredentialsName),
+				),
+			},
+			{
+				Config: config("second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_credentials.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "oauth.client_secret", "second"),
+					testAccCheckCredentialsName(t, server, project.ID, integration.ID, defaultIntegrationCredentialsName),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationCredentialsResourceSchemeConfig(server *fakeparagon.Server, projectID, integrationID, credentials string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_integration_credentials" "test" {
+  project_id     = %[1]q
+  integration_id = %[2]q
+
+  %[3]s
+}
+`, projectID, integrationID, credentials)
+}
+
+func TestAccIntegrationCredentialsResource_schemes(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-integration-credentials-schemes")
+	integration := server.AddIntegration(project.ID, "stripe")
+
+	var credentialID string
+
+	testAccCheckValues := func(expected map[string]interface{}) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			credential, err := c.GetDecryptedCredential(context.Background(), project.ID, credentialID)
+			if err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(credential.Values, expected) {
+				return fmt.Errorf("expected values %v, got %v", expected, credential.Values)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_integration_credentials", func(attributes map[string]string) error {
+			_, err := c.GetDecryptedCredential(context.Background(), attributes["project_id"], attributes["id"])
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationCredentialsResourceSchemeConfig(server, project.ID, integration.ID, `
+  oauth   = { client_id = "client-id", client_secret = "secret", scopes = ["read"] }
+  api_key = { value = "key" }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccIntegrationCredentialsResourceSchemeConfig(server, project.ID, integration.ID, `api_key = { value = "first" }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("paragon_integration_credentials.test", tfjsonpath.New("scheme"), knownvalue.StringExact("api_key")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "scheme", "api_key"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "api_key.value", "first"),
+					resource.TestCheckNoResourceAttr("paragon_integration_credentials.test", "oauth"),
+					testAccStateAttr("paragon_integration_credentials.test", "id", &credentialID),
+					testAccCheckValues(map[string]interface{}{"apiKey": "first"}),
+				),
+			},
+			{
+				// Values are updated in place.
+				Config: testAccIntegrationCredentialsResourceSchemeConfig(server, project.ID, integration.ID, `api_key = { value = "second" }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_credentials.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("paragon_integration_credentials.test", "id", &credentialID),
+					testAccCheckValues(map[string]interface{}{"apiKey": "second"}),
+				),
+			},
+			{
+				// Another scheme replaces the credentials.
+				Config: testAccIntegrationCredentialsResourceSchemeConfig(server, project.ID, integration.ID, `basic = { username = "user", password = "pass" }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_credentials.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "scheme", "basic"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "basic.username", "user"),
+					resource.TestCheckNoResourceAttr("paragon_integration_credentials.test", "api_key"),
+					testAccStateAttr("paragon_integration_credentials.test", "id", &credentialID),
+					testAccCheckValues(map[string]interface{}{"username": "user", "password": "pass"}),
+				),
+			},
+			{
+				ResourceName:      "paragon_integration_credentials.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateID("paragon_integration_credentials.test", "project_id", "integration_id"),
+			},
+			{
+				Config: testAccIntegrationCredentialsResourceSchemeConfig(server, project.ID, integration.ID, `
+  custom = {
+    accountId = "acct_1"
+    token     = "token"
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_credentials.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "scheme", "custom"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "custom.%", "2"),
+					testAccStateAttr("paragon_integration_credentials.test", "id", &credentialID),
+					testAccCheckValues(map[string]interface{}{"accountId": "acct_1", "token": "token"}),
+				),
+			},
+			{
+				ResourceName:      "paragon_integration_credentials.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateID("paragon_integration_credentials.test", "project_id", "integration_id"),
+			},
+		},
+	})
+}
+
+func TestAccIntegrationCredentialsResource_customIntegration(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-integration-credentials-custom")
+
+	config := testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_custom_integration" "test" {
+  project_id          = %[1]q
+  name                = "Acme"
+  api_base_url        = "https://api.acme.example.com"
+  authentication_type = "api_key"
+
+  api_key = {
+    fields = [{ key = "apiKey", label = "API key" }]
+  }
+}
+
+resource "paragon_integration_credentials" "test" {
+  project_id     = %[1]q
+  integration_id = paragon_custom_integration.test.integration_id
+
+  %%s
+}
+`, project.ID)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, `oauth = { client_id = "client-id", client_secret = "secret", scopes = ["read"] }`),
+				ExpectError: regexp.MustCompile(`The 'oauth' block is specified, but the custom integration's authentication\s+type is 'api_key'`),
+			},
+			{
+				Config: fmt.Sprintf(config, `api_key = { value = "key" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "scheme", "api_key"),
+					resource.TestCheckResourceAttr("paragon_integration_credentials.test", "creds_provider", "custom"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,232 @@
+// integration_resource.go
+package provider
+
+import (
+    "context"
+    "errors"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource                = &integrationResource{}
+    _ resource.ResourceWithConfigure   = &integrationResource{}
+    _ resource.ResourceWithImportState = &integrationResource{}
+)
+
+// NewIntegrationResource is a helper function to simplify the provider implementation.
+func NewIntegrationResource() resource.Resource {
+    return &integrationResource{}
+}
+
+// integrationResource is the resource implementation.
+type integrationResource struct {
+    client *client.Client
+}
+
+// integrationResourceModel maps the resource schema data.
+type integrationResourceModel struct {
+    ID                 types.String `tfsdk:"id"`
+    ProjectID          types.String `tfsdk:"project_id"`
+    Type               types.String `tfsdk:"type"`
+    Active             types.Bool   `tfsdk:"active"`
+    ConnectedUserCount types.Int64  `tfsdk:"connected_user_count"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *integrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// Schema defines the schema for the resource.
+func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Installs an integration in a project.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the integration.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "type": schema.StringAttribute{
+                Description: "Type of the integration, e.g. salesforce or hubspot. Custom integrations are installed by paragon_custom_integration.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                    stringvalidator.NoneOf("custom"),
+                },
+            },
+            "active": schema.BoolAttribute{
+                Description: "Indicates whether the integration is active or not. Use paragon_integration_status to change it.",
+                Computed:    true,
+            },
+            "connected_user_count": schema.Int64Attribute{
+                Description: "Number of users who connected their account to the integration.",
+                Computed:    true,
+            },
+        },
+    }
+}
+
+// mapIntegrationToModel sets the state from the integration returned by the API.
+func mapIntegrationToModel(integration *client.Integration, state *integrationResourceModel) {
+    state.ID = types.StringValue(integration.ID)
+    state.ProjectID = types.StringValue(integration.ProjectID)
+    state.Type = types.StringValue(integration.Type)
+    state.Active = types.BoolValue(integration.IsActive)
+    state.ConnectedUserCount = types.Int64Value(int64(integration.ConnectedUserCount))
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan integrationResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    integration, err := r.client.CreateIntegration(ctx, plan.ProjectID.ValueString(), plan.Type.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error creating integration",
+            "Could not create integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Map response body to schema and populate Computed attribute values
+    mapIntegrationToModel(integration, &plan)
+
+    // Set state to fully populated data
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state integrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    integration, err := r.client.GetIntegration(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error reading integration",
+            "Could not read integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Update the state with the latest data
+    mapIntegrationToModel(integration, &state)
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Update refreshes the Terraform state, as every configurable attribute requires a new integration.
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var state integrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    integration, err := r.client.GetIntegration(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integration",
+            "Could not read integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    mapIntegrationToModel(integration, &state)
+
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state integrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    err := r.client.DeleteIntegration(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+    if err != nil && !errors.Is(err, client.ErrNotFound) {
+        resp.Diagnostics.AddError(
+            "Error deleting integration",
+            "Could not delete integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+}
+
+// ImportState imports an existing integration by "project_id/integration", where integration is its identifier or type.
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, integration, ok := splitImportID(req, resp, "project_id/integration")
+    if !ok {
+        return
+    }
+
+    integrationID, ok := importIntegrationID(ctx, r.client, projectID, integration, resp)
+    if !ok {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), integrationID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccIntegrationResourceConfig(server *fakeparagon.Server, projectID string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_integration" "test" {
+  project_id = %[1]q
+  type       = "salesforce"
+}
+
+resource "paragon_integration_status" "test" {
+  project_id     = %[1]q
+  integration_id = paragon_integration.test.id
+  active         = true
+}
+`, projectID)
+}
+
+func TestAccIntegrationResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-integration")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			integrations, err := c.GetIntegrations(context.Background(), project.ID)
+			if err != nil {
+				return err
+			}
+			if len(integrations) != 0 {
+				return fmt.Errorf("expected no integrations, got %+v", integrations)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationResourceConfig(server, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("paragon_integration.test", "id"),
+					resource.TestCheckResourceAttr("paragon_integration.test", "type", "salesforce"),
+					resource.TestCheckResourceAttr("paragon_integration.test", "connected_user_count", "0"),
+					resource.TestCheckResourceAttrPair("paragon_integration_status.test", "id", "paragon_integration.test", "id"),
+				),
+			},
+			{
+				// The refreshed integration reflects its status.
+				Config: testAccIntegrationResourceConfig(server, project.ID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("paragon_integration.test", "active", "true"),
+			},
+			{
+				ResourceName:      "paragon_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     project.ID + "/salesforce",
+			},
+			{
+				// An integration removed outside of Terraform is installed again.
+				PreConfig: func() {
+					integrations, err := c.GetIntegrations(context.Background(), project.ID)
+					if err != nil {
+						t.Fatalf("listing integrations: %v", err)
+					}
+					for _, integration := range integrations {
+						if err := c.DeleteIntegration(context.Background(), project.ID, integration.ID); err != nil {
+							t.Fatalf("deleting integration: %v", err)
+						}
+					}
+				},
+				Config: testAccIntegrationResourceConfig(server, project.ID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
@@ -2,9 +2,10 @@ package provider
 
 import (
     "context"
+    "errors"
     "fmt"
-    "strings"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
     "github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,7 @@ import (
 var (
     _ resource.Resource              = &integrationStatusResource{}
     _ resource.ResourceWithConfigure = &integrationStatusResource{}
+    _ resource.ResourceWithImportState = &integrationStatusResource{}
 )
 
 // NewIntegrationStatusResource is a helper function to simplify the provider implementation.
@@ -98,7 +100,7 @@ func (r *integrationStatusResource) Create(ctx context.Context, req resource.Cre
     // Update the integration status
     integration, err := r.client.UpdateIntegrationStatus(ctx, projectID, integrationID, active)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                 "Integration not found",
                 fmt.Sprintf("Integration with ID '%s' not found in the project", integrationID),
@@ -139,7 +141,7 @@ func (r *integrationStatusResource) Read(ctx context.Context, req resource.ReadR
     // Retrieve the integration
     integration, err := r.client.GetIntegration(ctx, projectID, integrationID)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.State.RemoveResource(ctx)
         } else {
             resp.Diagnostics.AddError(
@@ -178,7 +180,7 @@ func (r *integrationStatusResource) Update(ctx context.Context, req resource.Upd
     // Update the integration status
     integration, err := r.client.UpdateIntegrationStatus(ctx, projectID, integrationID, active)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                 "Integration not found during update",
                 fmt.Sprintf("Integration with ID '%s' not found in the project", integrationID),
@@ -219,7 +221,7 @@ func (r *integrationStatusResource) Delete(ctx context.Context, req resource.Del
     // Update the integration status to inactive (false)
     _, err := r.client.UpdateIntegrationStatus(ctx, projectID, integrationID, false)
     if err != nil {
-        if !strings.Contains(err.Error(), "status code: 404") {
+        if !errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                 "Error updating integration status",
                 "Could not update integration status, unexpected error: "+err.Error(),
@@ -227,4 +229,22 @@ func (r *integrationStatusResource) Delete(ctx context.Context, req resource.Del
             return
         }
     }
-}
\ No newline at end of file
+}
+
+// ImportState imports the status of an integration by "project_id/integration", where integration is the
+// identifier or the type of the integration.
+func (r *integrationStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, integration, ok := splitImportID(req, resp, "project_id/integration")
+    if !ok {
+        return
+    }
+
+    integrationID, ok := importIntegrationID(ctx, r.client, projectID, integration, resp)
+    if !ok {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), integrationID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), integrationID)...)
+}
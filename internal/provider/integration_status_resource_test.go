@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccIntegrationStatusResourceConfig(server *fakeparagon.Server, projectID, integrationID string, active bool) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_integration_status" "test" {
+  project_id     = %[1]q
+  integration_id = %[2]q
+  active         = %[3]t
+}
+`, projectID, integrationID, active)
+}
+
+func TestAccIntegrationStatusResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-integration-status")
+	integration := server.AddIntegration(project.ID, "slack")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destroying the resource deactivates the integration.
+		CheckDestroy: func(*terraform.State) error {
+			current, err := c.GetIntegration(context.Background(), project.ID, integration.ID)
+			if err != nil {
+				return err
+			}
+			if current.IsActive {
+				return fmt.Errorf("expected integration %s to be inactive", integration.ID)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationStatusResourceConfig(server, project.ID, integration.ID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_integration_status.test", "active", "true"),
+					resource.TestCheckResourceAttr("paragon_integration_status.test", "id", integration.ID),
+				),
+			},
+			{
+				Config: testAccIntegrationStatusResourceConfig(server, project.ID, integration.ID, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_status.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("paragon_integration_status.test", "active", "false"),
+			},
+			{
+				// Integrations can be imported by type.
+				ResourceName:      "paragon_integration_status.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     project.ID + "/slack",
+			},
+			{
+				// An integration enabled outside of Terraform is disabled again.
+				PreConfig: func() {
+					if _, err := c.UpdateIntegrationStatus(context.Background(), project.ID, integration.ID, true); err != nil {
+						t.Fatalf("enabling integration: %v", err)
+					}
+				},
+				Config: testAccIntegrationStatusResourceConfig(server, project.ID, integration.ID, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_integration_status.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("paragon_integration_status.test", "active", "false"),
+			},
+		},
+	})
+}
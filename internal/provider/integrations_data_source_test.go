@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-integrations")
+	salesforce := server.AddIntegration(project.ID, "salesforce")
+	server.AddIntegration(project.ID, "slack")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_integrations" "test" {
+  project_id = %[1]q
+}
+`, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_integrations.test", "integrations.%", "2"),
+					resource.TestCheckResourceAttr("data.paragon_integrations.test", "integrations.salesforce.id", salesforce.ID),
+					resource.TestCheckResourceAttr("data.paragon_integrations.test", "integrations.salesforce.is_active", "false"),
+					resource.TestCheckResourceAttrSet("data.paragon_integrations.test", "integrations.slack.id"),
+				),
+			},
+		},
+	})
+}
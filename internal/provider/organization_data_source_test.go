@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_organization" "test" {
+  name = "default-organization"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_organization.test", "organization.id", server.DefaultOrganizationID()),
+					resource.TestCheckResourceAttr("data.paragon_organization.test", "organization.role", "ADMIN"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_organization" "test" {
+  name = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`Organization with name 'missing' not found`),
+			},
+		},
+	})
+}
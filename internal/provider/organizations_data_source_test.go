@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	second := server.AddOrganization("second-organization")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_organizations" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_organizations.test", "organizations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.paragon_organizations.test", "organizations.*", map[string]string{
+						"id":   second.ID,
+						"name": "second-organization",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// project_data_source.go
+package provider
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &projectDataSource{}
+    _ datasource.DataSourceWithConfigure = &projectDataSource{}
+)
+
+// NewProjectDataSource is a helper function to simplify the provider implementation.
+func NewProjectDataSource() datasource.DataSource {
+    return &projectDataSource{}
+}
+
+// projectDataSource is the data source implementation.
+type projectDataSource struct {
+    client *client.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    attributes := projectAttributes()
+    attributes["team_id"] = schema.StringAttribute{
+        Description: "The ID of the team the project belongs to.",
+        Required:    true,
+    }
+    attributes["id"] = schema.StringAttribute{
+        Description: "Identifier for the project. Either id or title must be set.",
+        Optional:    true,
+        Computed:    true,
+        Validators: []validator.String{
+            stringvalidator.ExactlyOneOf(path.MatchRoot("title")),
+        },
+    }
+    attributes["title"] = schema.StringAttribute{
+        Description: "The title of the project. Only Connect projects are matched, and the title must be unique in the team.",
+        Optional:    true,
+        Computed:    true,
+    }
+
+    resp.Schema = schema.Schema{
+        Description: "Fetches a project of a team by its ID or title.",
+        Attributes:  attributes,
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var config projectModel
+    diags := req.Config.Get(ctx, &config)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    teamID := config.TeamID.ValueString()
+
+    var foundProject *client.Project
+    if !config.ID.IsNull() {
+        project, err := d.client.GetProjectByID(ctx, config.ID.ValueString(), teamID)
+        if err != nil {
+            if errors.Is(err, client.ErrNotFound) {
+                resp.Diagnostics.AddError(
+                    "Project Not Found",
+                    fmt.Sprintf("Project with ID '%s' not found in team '%s'", config.ID.ValueString(), teamID),
+                )
+                return
+            }
+            resp.Diagnostics.AddError(
+                "Unable to Read Project",
+                err.Error(),
+            )
+            return
+        }
+        foundProject = project
+    } else {
+        title := config.Title.ValueString()
+
+        projects, err := d.client.GetProjects(ctx, teamID)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Projects",
+                err.Error(),
+            )
+            return
+        }
+
+        // Projects created with duplicate_name_allowed can share their title, so a match must be unique.
+        var matchingIDs []string
+        for _, project := range projects {
+            if project.Title == title && project.IsConnectProject {
+                project := project
+                foundProject = &project
+                matchingIDs = append(matchingIDs, project.ID)
+            }
+        }
+
+        if foundProject == nil {
+            resp.Diagnostics.AddError(
+                "Project Not Found",
+                fmt.Sprintf("Project with title '%s' not found in team '%s'", title, teamID),
+            )
+            return
+        }
+        if len(matchingIDs) > 1 {
+            resp.Diagnostics.AddError(
+                "Multiple Projects Found",
+                fmt.Sprintf("%d projects titled '%s' exist in team '%s' (%s), set id instead of title to choose one.",
+                    len(matchingIDs), title, teamID, strings.Join(matchingIDs, ", ")),
+            )
+            return
+        }
+    }
+
+    state := mapProjectToModel(*foundProject)
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
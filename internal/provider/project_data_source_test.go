@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-project")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_project" "test" {
+  team_id = %[1]q
+}
+`, project.TeamID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_project" "test" {
+  team_id = %[1]q
+  title   = "missing"
+}
+`, project.TeamID),
+				ExpectError: regexp.MustCompile(`Project with title 'missing' not found`),
+			},
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_project" "by_title" {
+  team_id = %[1]q
+  title   = "acc-project"
+}
+
+data "paragon_project" "by_id" {
+  team_id = %[1]q
+  id      = %[2]q
+}
+`, project.TeamID, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_project.by_title", "id", project.ID),
+					resource.TestCheckResourceAttr("data.paragon_project.by_title", "owner_id", server.DefaultUserID()),
+					resource.TestCheckResourceAttr("data.paragon_project.by_title", "is_connect_project", "true"),
+					resource.TestCheckResourceAttr("data.paragon_project.by_id", "title", "acc-project"),
+					resource.TestCheckResourceAttr("data.paragon_project.by_id", "team_id", project.TeamID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectDataSource_duplicateTitle(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-project-duplicate")
+	duplicate, err := testAccClient(t, server).CreateProjectInTeam(context.Background(), project.TeamID, "acc-project-duplicate")
+	if err != nil {
+		t.Fatalf("creating project: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_project" "test" {
+  team_id = %[1]q
+  title   = "acc-project-duplicate"
+}
+`, project.TeamID),
+				ExpectError: regexp.MustCompile(`Multiple Projects Found`),
+			},
+			{
+				// The ID tells duplicates apart.
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_project" "test" {
+  team_id = %[1]q
+  id      = %[2]q
+}
+`, project.TeamID, duplicate.ID),
+				Check: resource.TestCheckResourceAttr("data.paragon_project.test", "id", duplicate.ID),
+			},
+		},
+	})
+}
@@ -2,8 +2,8 @@ package provider
 
 import (
     "context"
+    "errors"
     "fmt"
-    "strings"
 
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,6 +22,7 @@ import (
 var (
     _ resource.Resource              = &projectResource{}
     _ resource.ResourceWithConfigure = &projectResource{}
+    _ resource.ResourceWithImportState = &projectResource{}
 )
 
 // NewProjectResource is a helper function to simplify the provider implementation.
@@ -93,8 +94,13 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
                 Computed:    true,
             },
             "team_id": schema.StringAttribute{
-                Description: "Identifier of the team associated with the project.",
+                Description: "Identifier of the team associated with the project. When set, the project is created in this existing team, otherwise a new team is created with the project.",
+                Optional:    true,
                 Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                    stringplanmodifier.RequiresReplace(),
+                },
             },
             "is_connect_project": schema.BoolAttribute{
                 Description: "Indicates if the project is a Connect project.",
@@ -141,8 +147,11 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
         }
 
         if len(teams) > 0 {
-            // Take the ID of the first team found
+            // Take the ID of the first team found, unless the project goes to a given team
             teamID := teams[0].ID
+            if !plan.TeamID.IsNull() && !plan.TeamID.IsUnknown() {
+                teamID = plan.TeamID.ValueString()
+            }
 
             // Get the list of projects for the team
             projects, err := r.client.GetProjects(ctx, teamID)
@@ -167,8 +176,14 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
         }
     }
 
-    // Create new project
-    project, olderProject, err := r.client.CreateProject(ctx, plan.OrganizationID.ValueString(), plan.Title.ValueString())
+    // Create new project, along with its own team unless one is given
+    var project, olderProject *client.Project
+    var err error
+    if !plan.TeamID.IsNull() && !plan.TeamID.IsUnknown() {
+        project, err = r.client.CreateProjectInTeam(ctx, plan.TeamID.ValueString(), plan.Title.ValueString())
+    } else {
+        project, olderProject, err = r.client.CreateProject(ctx, plan.OrganizationID.ValueString(), plan.Title.ValueString())
+    }
     if err != nil {
         resp.Diagnostics.AddError(
             "Error creating project",
@@ -217,6 +232,10 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
     // Retrieve the projects using the GetProjects function
     projects, err := r.client.GetProjects(ctx, teamID)
     if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
         resp.Diagnostics.AddError(
             "Error reading projects",
             "Could not read projects, unexpected error: "+err.Error(),
@@ -246,11 +265,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
     state.TeamID = types.StringValue(foundProject.TeamID)
     state.IsConnectProject = types.BoolValue(foundProject.IsConnectProject)
     state.IsHidden = types.BoolValue(foundProject.IsHidden)
-    state.AutomateProjectID = state.AutomateProjectID
 
-    // We will take that from state and not read it from server. as this is an unimportant project,
-    // we keep this just for deletion purposes.
-    state.AutomateProjectID = state.AutomateProjectID
+    // automate_project_id is kept as-is from state and not read from the server. As this is an unimportant
+    // project, we keep it just for deletion purposes.
 
     // Set the refreshed state
     diags = resp.State.Set(ctx, &state)
@@ -276,8 +293,8 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
         return
     }
 
-    // Check if the duplicate_name_allowed has changed
-    if !plan.DuplicateNameAllowed.Equal(state.DuplicateNameAllowed) {
+    // Check if the duplicate_name_allowed has changed. Imported projects have no value yet, so any is accepted.
+    if !state.DuplicateNameAllowed.IsNull() && !plan.DuplicateNameAllowed.Equal(state.DuplicateNameAllowed) {
         resp.Diagnostics.AddAttributeError(
             path.Root("duplicate_name_allowed"),
             "Immutable Attribute Change",
@@ -290,12 +307,20 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
     projectID := state.ID.ValueString()
     teamID := state.TeamID.ValueString()
 
+    // Keep the computed attributes when only duplicate_name_allowed is set
+    plan.ID = state.ID
+    plan.OwnerID = state.OwnerID
+    plan.TeamID = state.TeamID
+    plan.IsConnectProject = state.IsConnectProject
+    plan.IsHidden = state.IsHidden
+    plan.AutomateProjectID = state.AutomateProjectID
+
     // Check if the name has changed
     if !plan.Title.Equal(state.Title) {
         // Update the project title using the UpdateProjectTitle function
         updatedProject, err := r.client.UpdateProjectTitle(ctx, projectID, teamID, plan.Title.ValueString())
         if err != nil {
-            if strings.Contains(err.Error(), "status code: 404") {
+            if errors.Is(err, client.ErrNotFound) {
                 resp.Diagnostics.AddError(
                     "Project not found during update",
                     "The project was not found while attempting to update it. This is an unexpected error.",
@@ -315,7 +340,6 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
         plan.TeamID = types.StringValue(updatedProject.TeamID)
         plan.IsConnectProject = types.BoolValue(updatedProject.IsConnectProject)
         plan.IsHidden = types.BoolValue(updatedProject.IsHidden)
-        plan.AutomateProjectID = state.AutomateProjectID
         state.Title = types.StringValue(updatedProject.Title)
     }
 
@@ -345,7 +369,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
     // Delete the project using the DeleteProject function
     err := r.client.DeleteProject(ctx, projectID, teamID)
     if err != nil {
-        if !strings.Contains(err.Error(), "status code: 404") {
+        if !errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                 "Error deleting project",
                 "Could not delete project, unexpected error: "+err.Error(),
@@ -358,7 +382,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
     if automateProjectID != "" {
         errOlder := r.client.DeleteProject(ctx, automateProjectID, teamID)
         if errOlder != nil {
-            if !strings.Contains(errOlder.Error(), "status code: 404") {
+            if !errors.Is(errOlder, client.ErrNotFound) {
                 resp.Diagnostics.AddError(
                     "Error deleting automate project ID",
                     "Could not delete project, unexpected error: "+errOlder.Error(),
@@ -367,4 +391,28 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
             }
         }
     }
-}
\ No newline at end of file
+}
+
+// ImportState imports an existing project by "team_id/project_id".
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    teamID, projectID, ok := splitImportID(req, resp, "team_id/project_id")
+    if !ok {
+        return
+    }
+
+    // The organization of the project is read from its team
+    team, err := r.client.GetTeamByID(ctx, teamID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error importing project",
+            "Could not read team "+teamID+", unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), team.OrganizationID)...)
+    // The automate project can't be found from the Connect project, so it is left behind on delete
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("automate_project_id"), "")...)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+func testAccProjectResourceConfig(server *fakeparagon.Server, title string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_project" "test" {
+  organization_id = %[1]q
+  title           = %[2]q
+}
+`, server.DefaultOrganizationID(), title)
+}
+
+func TestAccProjectResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+
+	var projectID, teamID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_project", func(attributes map[string]string) error {
+			_, err := c.GetProjectByID(context.Background(), attributes["id"], attributes["team_id"])
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(server, "acc-project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_project.test", "title", "acc-project"),
+					resource.TestCheckResourceAttr("paragon_project.test", "is_connect_project", "true"),
+					resource.TestCheckResourceAttr("paragon_project.test", "automate_project_id", ""),
+					resource.TestCheckResourceAttrSet("paragon_project.test", "id"),
+					resource.TestCheckResourceAttrSet("paragon_project.test", "team_id"),
+					resource.TestCheckResourceAttrSet("paragon_project.test", "owner_id"),
+					testAccStateAttr("paragon_project.test", "id", &projectID),
+					testAccStateAttr("paragon_project.test", "team_id", &teamID),
+				),
+			},
+			{
+				Config: testAccProjectResourceConfig(server, "acc-project-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_project.test", "title", "acc-project-renamed"),
+					resource.TestCheckResourceAttrPtr("paragon_project.test", "id", &projectID),
+				),
+			},
+			{
+				ResourceName:      "paragon_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateID("paragon_project.test", "team_id", "id"),
+			},
+			{
+				// A project deleted outside of Terraform is created again.
+				PreConfig: func() {
+					if err := c.DeleteProject(context.Background(), projectID, teamID); err != nil {
+						t.Fatalf("deleting project: %v", err)
+					}
+				},
+				Config: testAccProjectResourceConfig(server, "acc-project-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_project.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_duplicateName(t *testing.T) {
+	server := testAccServer(t)
+	testAccProject(t, server, "acc-duplicate")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectResourceConfig(server, "acc-duplicate"),
+				ExpectError: regexp.MustCompile(`A project with the name 'acc-duplicate' already exists`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_importInvalidID(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccProjectResourceConfig(server, "acc-project-import"),
+				ResourceName:  "paragon_project.test",
+				ImportState:   true,
+				ImportStateId: "missing-project-id",
+				ExpectError:   regexp.MustCompile(`Expected an import ID of the form "team_id/project_id"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,160 @@
+// projects_data_source.go
+package provider
+
+import (
+    "context"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &projectsDataSource{}
+    _ datasource.DataSourceWithConfigure = &projectsDataSource{}
+)
+
+// NewProjectsDataSource is a helper function to simplify the provider implementation.
+func NewProjectsDataSource() datasource.DataSource {
+    return &projectsDataSource{}
+}
+
+// projectsDataSource is the data source implementation.
+type projectsDataSource struct {
+    client *client.Client
+}
+
+// projectsDataSourceModel maps the data source schema data.
+type projectsDataSourceModel struct {
+    TeamID   types.String   `tfsdk:"team_id"`
+    Projects []projectModel `tfsdk:"projects"`
+}
+
+type projectModel struct {
+    ID               types.String `tfsdk:"id"`
+    DateCreated      types.String `tfsdk:"date_created"`
+    DateUpdated      types.String `tfsdk:"date_updated"`
+    Title            types.String `tfsdk:"title"`
+    OwnerID          types.String `tfsdk:"owner_id"`
+    TeamID           types.String `tfsdk:"team_id"`
+    IsConnectProject types.Bool   `tfsdk:"is_connect_project"`
+    IsHidden         types.Bool   `tfsdk:"is_hidden"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *projectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the projects of a team.",
+        Attributes: map[string]schema.Attribute{
+            "team_id": schema.StringAttribute{
+                Description: "The ID of the team.",
+                Required:    true,
+            },
+            "projects": schema.ListNestedAttribute{
+                Description: "The list of projects.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: projectAttributes(),
+                },
+            },
+        },
+    }
+}
+
+// projectAttributes returns the schema attributes of a project, shared by the data sources that read projects.
+func projectAttributes() map[string]schema.Attribute {
+    return map[string]schema.Attribute{
+        "id": schema.StringAttribute{
+            Description: "Identifier for the project.",
+            Computed:    true,
+        },
+        "date_created": schema.StringAttribute{
+            Description: "The creation date of the project.",
+            Computed:    true,
+        },
+        "date_updated": schema.StringAttribute{
+            Description: "The last update date of the project.",
+            Computed:    true,
+        },
+        "title": schema.StringAttribute{
+            Description: "The title of the project.",
+            Computed:    true,
+        },
+        "owner_id": schema.StringAttribute{
+            Description: "The ID of the user who owns the project.",
+            Computed:    true,
+        },
+        "team_id": schema.StringAttribute{
+            Description: "The ID of the team the project belongs to.",
+            Computed:    true,
+        },
+        "is_connect_project": schema.BoolAttribute{
+            Description: "Indicates if the project is a Connect project, rather than an older Automate project.",
+            Computed:    true,
+        },
+        "is_hidden": schema.BoolAttribute{
+            Description: "Indicates if the project is hidden.",
+            Computed:    true,
+        },
+    }
+}
+
+func mapProjectToModel(project client.Project) projectModel {
+    return projectModel{
+        ID:               types.StringValue(project.ID),
+        DateCreated:      types.StringValue(project.DateCreated),
+        DateUpdated:      types.StringValue(project.DateUpdated),
+        Title:            types.StringValue(project.Title),
+        OwnerID:          types.StringValue(project.OwnerID),
+        TeamID:           types.StringValue(project.TeamID),
+        IsConnectProject: types.BoolValue(project.IsConnectProject),
+        IsHidden:         types.BoolValue(project.IsHidden),
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state projectsDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projects, err := d.client.GetProjects(ctx, state.TeamID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Projects",
+            err.Error(),
+        )
+        return
+    }
+
+    state.Projects = []projectModel{}
+    for _, project := range projects {
+        state.Projects = append(state.Projects, mapProjectToModel(project))
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-projects")
+	other, err := testAccClient(t, server).CreateProjectInTeam(context.Background(), project.TeamID, "acc-projects-other")
+	if err != nil {
+		t.Fatalf("creating project: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_projects" "test" {
+  team_id = "` + project.TeamID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_projects.test", "projects.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.paragon_projects.test", "projects.*", map[string]string{
+						"id":                 project.ID,
+						"title":              "acc-projects",
+						"owner_id":           server.DefaultUserID(),
+						"team_id":            project.TeamID,
+						"is_connect_project": "true",
+						"is_hidden":          "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.paragon_projects.test", "projects.*", map[string]string{
+						"id":    other.ID,
+						"title": "acc-projects-other",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -16,7 +23,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &paragonProvider{}
+	_ provider.Provider                       = &paragonProvider{}
+	_ provider.ProviderWithFunctions          = &paragonProvider{}
+	_ provider.ProviderWithEphemeralResources = &paragonProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -38,9 +47,21 @@ type paragonProvider struct {
 
 // paragonProviderModel maps provider schema data to a Go type.
 type paragonProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	BaseURL  types.String `tfsdk:"base_url"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	CLIKey       types.String `tfsdk:"cli_key"`
+	Profile      types.String `tfsdk:"profile"`
+	BaseURL      types.String `tfsdk:"base_url"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	ExtraHeaders       types.Map    `tfsdk:"extra_headers"`
 }
 
 // Metadata returns the provider type name.
@@ -54,17 +75,68 @@ func (p *paragonProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"username": schema.StringAttribute{
-				Required:    true,
-				Description: "The username for authenticating with the Paragon service.",
+				Optional:    true,
+				Description: "The username for authenticating with the Paragon service. Must be set together with `password`. Can also be set with the PARAGON_USERNAME environment variable.",
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password for authenticating with the Paragon service. Must be set together with `username`. Can also be set with the PARAGON_PASSWORD environment variable.",
+			},
+			"access_token": schema.StringAttribute{
+				Optional:    true,
 				Sensitive:   true,
-				Description: "The password for authenticating with the Paragon service.",
+				Description: "A pre-issued Paragon access token to authenticate with instead of username and password. The token is not refreshed when it expires. Can also be set with the PARAGON_ACCESS_TOKEN environment variable.",
+			},
+			"cli_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A Paragon CLI key (as created by `paragon_cli_key`) to authenticate with instead of username and password. Recommended for CI pipelines. Can also be set with the PARAGON_CLI_KEY environment variable.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a profile in the Paragon CLI credentials file (`~/.paragon/credentials.json`) to read the CLI key and base URL from. Can also be set with the PARAGON_PROFILE environment variable.",
 			},
 			"base_url": schema.StringAttribute{
 				Optional:    true,
-				Description: "The base URL of the Paragon service. Defaults to 'https://zeus.useparagon.com'.",
+				Description: "The base URL of the Paragon service. Defaults to 'https://zeus.useparagon.com'. Can also be set with the PARAGON_BASE_URL environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of times a request is retried after a rate limit (429) or a transient server error (5xx). Defaults to 3, set to 0 to disable retries.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait between two retries, as a duration string (e.g. '30s', '2m'). Defaults to '30s'.",
+			},
+			"http_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy to send requests through. Defaults to the proxy set in the HTTP_PROXY/HTTPS_PROXY environment variables.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded CA bundle to trust on top of the system certificates, e.g. for a TLS inspecting proxy. Conflicts with `ca_cert_pem`.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA bundle to trust on top of the system certificates. Conflicts with `ca_cert_file`.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification. Only meant for self-hosted test stacks. Defaults to false.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout of a single request, as a duration string (e.g. '60s', '2m'). Defaults to '60s'.",
+			},
+			"extra_headers": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Additional HTTP headers to send with every request.",
 			},
 		},
 	}
@@ -103,21 +175,163 @@ func (p *paragonProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Paragon Access Token",
+			"The provider cannot create the Paragon API client as there is an unknown configuration value for the Paragon API access token.",
+		)
+	}
+
+	if config.CLIKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cli_key"),
+			"Unknown Paragon CLI Key",
+			"The provider cannot create the Paragon API client as there is an unknown configuration value for the Paragon CLI key.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set the base URL, using the default value if not provided
-	baseURL := "https://zeus.useparagon.com"
-	if !config.BaseURL.IsNull() && !config.BaseURL.IsUnknown() {
-		baseURL = config.BaseURL.ValueString()
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown Paragon Profile",
+			"The provider cannot create the Paragon API client as there is an unknown configuration value for the Paragon profile.",
+		)
+		return
+	}
+
+	// Load the credentials profile, if one was requested
+	profileName := configuredProfileName(config)
+
+	var profile *credentialsProfile
+	if profileName != "" {
+		var err error
+		profile, err = loadCredentialsProfile(profileName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Load Paragon Profile",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	creds := resolveCredentials(config, profileName, profile)
+	if err := creds.validate(); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Paragon Authentication Configuration",
+			"The provider cannot create the Paragon API client: "+err.Error()+". "+
+				"Credentials can also be set with the "+envUsername+" and "+envPassword+", "+envAccessToken+" or "+envCLIKey+" environment variables, or with a `profile`.",
+		)
+		return
+	}
+
+	baseURL := resolveBaseURL(config, profile)
+
+	// Set the retry policy, using the default values if not provided
+	maxRetries := client.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := client.DefaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		wait, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil || wait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("The value '%s' is not a valid positive duration, e.g. '30s' or '2m'.", config.RetryMaxWait.ValueString()),
+			)
+			return
+		}
+		retryMaxWait = wait
+	}
+
+	// Set up the network transport
+	transportConfig := client.TransportConfig{
+		ProxyURL:           config.HTTPProxy.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	}
+
+	if !config.CACertFile.IsNull() && !config.CACertPEM.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Conflicting CA Bundle Configuration",
+			"Only one of `ca_cert_file` and `ca_cert_pem` can be set.",
+		)
+		return
+	}
+
+	if !config.CACertFile.IsNull() {
+		pem, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Bundle",
+				"Could not read the CA bundle file: "+err.Error(),
+			)
+			return
+		}
+		transportConfig.CACertPEM = pem
+	}
+
+	if !config.CACertPEM.IsNull() {
+		transportConfig.CACertPEM = []byte(config.CACertPEM.ValueString())
+	}
+
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("The value '%s' is not a valid positive duration, e.g. '60s' or '2m'.", config.RequestTimeout.ValueString()),
+			)
+			return
+		}
+		transportConfig.Timeout = timeout
+	}
+
+	httpClient, err := client.NewHTTPClient(transportConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Paragon Transport Configuration",
+			"The provider cannot create the Paragon API client: "+err.Error(),
+		)
+		return
+	}
+
+	extraHeaders := make(map[string]string)
+	if !config.ExtraHeaders.IsNull() && !config.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Create the Paragon API client
-	api := client.NewClient(baseURL)
+	api := client.NewClient(
+		baseURL,
+		client.WithRetries(maxRetries, retryMaxWait),
+		client.WithHTTPClient(httpClient),
+		client.WithExtraHeaders(extraHeaders),
+	)
 
     // Authenticate with the Paragon service
-    err := api.Authenticate(ctx, config.Username.ValueString(), config.Password.ValueString())
+    switch {
+    case creds.AccessToken != "":
+        err = api.AuthenticateWithToken(ctx, creds.AccessToken)
+    case creds.CLIKey != "":
+        err = api.AuthenticateWithToken(ctx, creds.CLIKey)
+    default:
+        err = api.Authenticate(ctx, creds.Username, creds.Password)
+    }
     if err != nil {
         resp.Diagnostics.AddError(
             "Unable to Authenticate with Paragon API",
@@ -128,10 +342,11 @@ func (p *paragonProvider) Configure(ctx context.Context, req provider.ConfigureR
         return
     }
 
-    // Make the Paragon client available during DataSource and Resource
-    // type Configure methods.
+    // Make the Paragon client available during DataSource, Resource and
+    // EphemeralResource type Configure methods.
     resp.DataSourceData = api
     resp.ResourceData = api
+    resp.EphemeralResourceData = api
 
 	tflog.Info(ctx, "Configured Paragon client", map[string]any{"success": true})
 }
@@ -141,9 +356,14 @@ func (p *paragonProvider) DataSources(_ context.Context) []func() datasource.Dat
     return []func() datasource.DataSource{
         NewOrganizationsDataSource,
         NewOrganizationDataSource,
+        NewCurrentUserDataSource,
         NewTeamsDataSource,
         NewTeamDataSource,
+        NewProjectsDataSource,
+        NewProjectDataSource,
         NewIntegrationsDataSource,
+        NewSDKKeysDataSource,
+        NewEnvironmentSecretsDataSource,
         NewWorkflowDataSource,
         NewWorkflowsDataSource,
     }
@@ -155,10 +375,29 @@ func (p *paragonProvider) Resources(_ context.Context) []func() resource.Resourc
         NewProjectResource,
         NewSDKKeysResource,
         NewEnvironmentSecretResource,
+        NewEnvironmentSecretsResource,
+        NewTeamResource,
         NewTeamMemberResource,
         NewCLIKeyResource,
         NewIntegrationCredentialsResource,
+        NewIntegrationResource,
         NewIntegrationStatusResource,
+        NewCustomIntegrationResource,
         NewEventsDestinationResource,
     }
-}
\ No newline at end of file
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *paragonProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+    return []func() ephemeral.EphemeralResource{
+        NewIntegrationCredentialsEphemeralResource,
+    }
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *paragonProvider) Functions(_ context.Context) []func() function.Function {
+    return []func() function.Function{
+        NewSignUserTokenFunction,
+        NewVerifyUserTokenFunction,
+    }
+}
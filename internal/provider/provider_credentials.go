@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Environment variables read when the matching provider attribute is not set.
+const (
+	envUsername        = "PARAGON_USERNAME"
+	envPassword        = "PARAGON_PASSWORD"
+	envAccessToken     = "PARAGON_ACCESS_TOKEN"
+	envCLIKey          = "PARAGON_CLI_KEY"
+	envBaseURL         = "PARAGON_BASE_URL"
+	envProfile         = "PARAGON_PROFILE"
+	envCredentialsFile = "PARAGON_CREDENTIALS_FILE"
+)
+
+// defaultBaseURL is the Paragon API used when no base URL is configured.
+const defaultBaseURL = "https://zeus.useparagon.com"
+
+// defaultCredentialsFile is where the Paragon CLI keeps its profiles, relative to the home directory.
+const defaultCredentialsFile = ".paragon/credentials.json"
+
+// paragonCredentials holds one set of authentication values, coming from a single source.
+type paragonCredentials struct {
+	Username    string
+	Password    string
+	AccessToken string
+	CLIKey      string
+	Source      string
+}
+
+func (c paragonCredentials) isEmpty() bool {
+	return c.Username == "" && c.Password == "" && c.AccessToken == "" && c.CLIKey == ""
+}
+
+// validate makes sure exactly one authentication method is set.
+func (c paragonCredentials) validate() error {
+	usePassword := c.Username != "" || c.Password != ""
+
+	authMethods := 0
+	for _, used := range []bool{usePassword, c.AccessToken != "", c.CLIKey != ""} {
+		if used {
+			authMethods++
+		}
+	}
+
+	if authMethods != 1 {
+		return fmt.Errorf("exactly one authentication method must be configured in %s: `username` and `password`, `access_token`, or `cli_key`", c.Source)
+	}
+
+	if usePassword && (c.Username == "" || c.Password == "") {
+		return fmt.Errorf("`username` and `password` must be set together in %s", c.Source)
+	}
+
+	return nil
+}
+
+func credentialsFromConfig(config paragonProviderModel) paragonCredentials {
+	return paragonCredentials{
+		Username:    config.Username.ValueString(),
+		Password:    config.Password.ValueString(),
+		AccessToken: config.AccessToken.ValueString(),
+		CLIKey:      config.CLIKey.ValueString(),
+		Source:      "the provider configuration",
+	}
+}
+
+func credentialsFromEnv() paragonCredentials {
+	return paragonCredentials{
+		Username:    os.Getenv(envUsername),
+		Password:    os.Getenv(envPassword),
+		AccessToken: os.Getenv(envAccessToken),
+		CLIKey:      os.Getenv(envCLIKey),
+		Source:      "the environment variables",
+	}
+}
+
+// credentialsProfile is a single profile of the Paragon CLI credentials file, which maps profile names to:
+//
+//	{
+//	  "default": {
+//	    "key": "<cli key>",
+//	    "baseUrl": "https://zeus.useparagon.com"
+//	  }
+//	}
+type credentialsProfile struct {
+	Key         string `json:"key"`
+	AccessToken string `json:"accessToken"`
+	BaseURL     string `json:"baseUrl"`
+}
+
+func (p credentialsProfile) credentials(name string) paragonCredentials {
+	return paragonCredentials{
+		AccessToken: p.AccessToken,
+		CLIKey:      p.Key,
+		Source:      fmt.Sprintf("profile '%s'", name),
+	}
+}
+
+// credentialsFilePath returns the credentials file location, which can be overridden with PARAGON_CREDENTIALS_FILE.
+func credentialsFilePath() (string, error) {
+	if path := os.Getenv(envCredentialsFile); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, defaultCredentialsFile), nil
+}
+
+// loadCredentialsProfile reads the named profile from the credentials file.
+func loadCredentialsProfile(name string) (*credentialsProfile, error) {
+	path, err := credentialsFilePath()
+	if err != nil {
+		return nil, fmt.Errorf("could not locate the credentials file: %w", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the credentials file: %w", err)
+	}
+
+	var profiles map[string]credentialsProfile
+	if err := json.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("could not parse the credentials file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile '%s' not found in the credentials file %s", name, path)
+	}
+
+	return &profile, nil
+}
+
+// configuredProfileName returns the profile to load: the provider configuration takes precedence over
+// PARAGON_PROFILE. An empty name means no profile is used.
+func configuredProfileName(config paragonProviderModel) string {
+	if !config.Profile.IsNull() {
+		return config.Profile.ValueString()
+	}
+	return os.Getenv(envProfile)
+}
+
+// resolveCredentials takes the credentials as a whole from the first source that has any, in order of
+// precedence: the provider configuration, the environment variables, and finally the profile.
+func resolveCredentials(config paragonProviderModel, profileName string, profile *credentialsProfile) paragonCredentials {
+	creds := credentialsFromConfig(config)
+	if creds.isEmpty() {
+		creds = credentialsFromEnv()
+	}
+	if creds.isEmpty() && profile != nil {
+		creds = profile.credentials(profileName)
+	}
+	return creds
+}
+
+// resolveBaseURL returns the base URL, in order of precedence: the provider configuration, the environment
+// variable, the profile, and finally the default value.
+func resolveBaseURL(config paragonProviderModel, profile *credentialsProfile) string {
+	switch {
+	case !config.BaseURL.IsNull() && !config.BaseURL.IsUnknown():
+		return config.BaseURL.ValueString()
+	case os.Getenv(envBaseURL) != "":
+		return os.Getenv(envBaseURL)
+	case profile != nil && profile.BaseURL != "":
+		return profile.BaseURL
+	}
+	return defaultBaseURL
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCredentialsEnv clears the environment variables read by the provider and points PARAGON_CREDENTIALS_FILE at
+// a temporary credentials file with the given content.
+func testCredentialsEnv(t *testing.T, credentialsFile string) string {
+	t.Helper()
+
+	for _, name := range []string{envUsername, envPassword, envAccessToken, envCLIKey, envBaseURL, envProfile} {
+		t.Setenv(name, "")
+	}
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(credentialsFile), 0o600); err != nil {
+		t.Fatalf("writing credentials file: %v", err)
+	}
+	t.Setenv(envCredentialsFile, path)
+	return path
+}
+
+const testCredentialsFile = `{
+  "default": {"key": "default-cli-key", "baseUrl": "https://default.example.com"},
+  "staging": {"accessToken": "staging-token"}
+}`
+
+func testProviderModel() paragonProviderModel {
+	return paragonProviderModel{
+		Username:    types.StringNull(),
+		Password:    types.StringNull(),
+		AccessToken: types.StringNull(),
+		CLIKey:      types.StringNull(),
+		Profile:     types.StringNull(),
+		BaseURL:     types.StringNull(),
+	}
+}
+
+// testResolveCredentials resolves the credentials and base URL the way Configure does.
+func testResolveCredentials(t *testing.T, config paragonProviderModel) (paragonCredentials, string) {
+	t.Helper()
+
+	profileName := configuredProfileName(config)
+	var profile *credentialsProfile
+	if profileName != "" {
+		var err error
+		profile, err = loadCredentialsProfile(profileName)
+		if err != nil {
+			t.Fatalf("loading profile: %v", err)
+		}
+	}
+	return resolveCredentials(config, profileName, profile), resolveBaseURL(config, profile)
+}
+
+func TestResolveCredentialsPrecedence(t *testing.T) {
+	testCredentialsEnv(t, testCredentialsFile)
+	t.Setenv(envProfile, "default")
+	t.Setenv(envAccessToken, "env-token")
+	t.Setenv(envBaseURL, "https://env.example.com")
+
+	// The provider configuration wins over the environment and the profile, as a whole.
+	config := testProviderModel()
+	config.Username = types.StringValue("user@example.com")
+	config.Password = types.StringValue("password")
+	config.BaseURL = types.StringValue("https://config.example.com")
+
+	creds, baseURL := testResolveCredentials(t, config)
+	if creds.Username != "user@example.com" || creds.AccessToken != "" || creds.CLIKey != "" {
+		t.Errorf("expected the credentials of the provider configuration, got %+v", creds)
+	}
+	if creds.Source != "the provider configuration" {
+		t.Errorf("unexpected source %q", creds.Source)
+	}
+	if baseURL != "https://config.example.com" {
+		t.Errorf("expected the configured base URL, got %q", baseURL)
+	}
+
+	// The environment wins over the profile.
+	creds, baseURL = testResolveCredentials(t, testProviderModel())
+	if creds.AccessToken != "env-token" || creds.CLIKey != "" {
+		t.Errorf("expected the credentials of the environment, got %+v", creds)
+	}
+	if baseURL != "https://env.example.com" {
+		t.Errorf("expected the base URL of the environment, got %q", baseURL)
+	}
+
+	// The profile is used last.
+	t.Setenv(envAccessToken, "")
+	t.Setenv(envBaseURL, "")
+	creds, baseURL = testResolveCredentials(t, testProviderModel())
+	if creds.CLIKey != "default-cli-key" || creds.Source != "profile 'default'" {
+		t.Errorf("expected the credentials of the profile, got %+v", creds)
+	}
+	if baseURL != "https://default.example.com" {
+		t.Errorf("expected the base URL of the profile, got %q", baseURL)
+	}
+	if err := creds.validate(); err != nil {
+		t.Errorf("expected the profile credentials to be valid, got %v", err)
+	}
+}
+
+func TestResolveCredentialsProfile(t *testing.T) {
+	testCredentialsEnv(t, testCredentialsFile)
+	t.Setenv(envProfile, "default")
+
+	// The configured profile wins over PARAGON_PROFILE.
+	config := testProviderModel()
+	config.Profile = types.StringValue("staging")
+
+	creds, baseURL := testResolveCredentials(t, config)
+	if creds.AccessToken != "staging-token" || creds.Source != "profile 'staging'" {
+		t.Errorf("expected the credentials of the configured profile, got %+v", creds)
+	}
+	if baseURL != defaultBaseURL {
+		t.Errorf("expected the default base URL for a profile without one, got %q", baseURL)
+	}
+
+	// Without a profile there are no credentials.
+	t.Setenv(envProfile, "")
+	if name := configuredProfileName(testProviderModel()); name != "" {
+		t.Errorf("expected no profile, got %q", name)
+	}
+	creds, _ = testResolveCredentials(t, testProviderModel())
+	if !creds.isEmpty() {
+		t.Errorf("expected no credentials, got %+v", creds)
+	}
+	if err := creds.validate(); err == nil || !strings.Contains(err.Error(), "exactly one authentication method") {
+		t.Errorf("expected missing credentials to be invalid, got %v", err)
+	}
+}
+
+func TestLoadCredentialsProfileErrors(t *testing.T) {
+	path := testCredentialsEnv(t, testCredentialsFile)
+
+	if _, err := loadCredentialsProfile("missing"); err == nil || !strings.Contains(err.Error(), "profile 'missing' not found in the credentials file "+path) {
+		t.Errorf("expected a missing profile error, got %v", err)
+	}
+
+	testCredentialsEnv(t, `{"default": `)
+	if _, err := loadCredentialsProfile("default"); err == nil || !strings.Contains(err.Error(), "could not parse the credentials file") {
+		t.Errorf("expected a parse error, got %v", err)
+	}
+
+	t.Setenv(envCredentialsFile, filepath.Join(t.TempDir(), "missing.json"))
+	if _, err := loadCredentialsProfile("default"); err == nil || !strings.Contains(err.Error(), "could not read the credentials file") {
+		t.Errorf("expected a read error, got %v", err)
+	}
+}
+
+func TestCredentialsFilePath(t *testing.T) {
+	t.Setenv(envCredentialsFile, "")
+	t.Setenv("HOME", "/home/paragon")
+
+	path, err := credentialsFilePath()
+	if err != nil {
+		t.Fatalf("locating credentials file: %v", err)
+	}
+	if path != filepath.Join("/home/paragon", ".paragon", "credentials.json") {
+		t.Errorf("expected the credentials file in the home directory, got %q", path)
+	}
+
+	t.Setenv(envCredentialsFile, "/etc/paragon.json")
+	if path, _ := credentialsFilePath(); path != "/etc/paragon.json" {
+		t.Errorf("expected PARAGON_CREDENTIALS_FILE to override the path, got %q", path)
+	}
+}
+
+func TestCredentialsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		creds   paragonCredentials
+		wantErr string
+	}{
+		{"password", paragonCredentials{Username: "user", Password: "password"}, ""},
+		{"access token", paragonCredentials{AccessToken: "token"}, ""},
+		{"cli key", paragonCredentials{CLIKey: "key"}, ""},
+		{"none", paragonCredentials{}, "exactly one authentication method"},
+		{"two methods", paragonCredentials{AccessToken: "token", CLIKey: "key"}, "exactly one authentication method"},
+		{"username only", paragonCredentials{Username: "user"}, "must be set together"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.creds.Source = "the test"
+			err := tt.creds.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), "the test") {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
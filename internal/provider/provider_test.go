@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/client"
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate the provider during acceptance testing. The factory
+// function is called for each Terraform CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"paragon": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccServer starts a fake Paragon API for a single acceptance test. Acceptance tests never talk to the real
+// Paragon API, so they only need TF_ACC and a Terraform CLI to run.
+func testAccServer(t *testing.T) *fakeparagon.Server {
+	t.Helper()
+	return fakeparagon.New(t)
+}
+
+// testAccProviderConfig points the provider at the fake server.
+func testAccProviderConfig(server *fakeparagon.Server) string {
+	return fmt.Sprintf(`
+provider "paragon" {
+  base_url    = %[1]q
+  username    = %[2]q
+  password    = %[3]q
+  max_retries = 0
+}
+`, server.URL, fakeparagon.DefaultEmail, fakeparagon.DefaultPassword)
+}
+
+// testAccProviderConfigCLIKey configures the provider with a new CLI key of the default user, which carries no
+// claims unlike the access tokens of username and password logins.
+func testAccProviderConfigCLIKey(t *testing.T, server *fakeparagon.Server) string {
+	t.Helper()
+
+	key, err := testAccClient(t, server).CreateCLIKey(context.Background(), "acc-"+t.Name())
+	if err != nil {
+		t.Fatalf("creating CLI key: %v", err)
+	}
+	return fmt.Sprintf(`
+provider "paragon" {
+  base_url    = %[1]q
+  cli_key     = %[2]q
+  max_retries = 0
+}
+`, server.URL, key.Key)
+}
+
+// testAccClient returns a client authenticated against the fake server, to arrange or alter data behind
+// Terraform's back.
+func testAccClient(t *testing.T, server *fakeparagon.Server) *client.Client {
+	t.Helper()
+
+	c := client.NewClient(server.URL)
+	if err := c.Authenticate(context.Background(), fakeparagon.DefaultEmail, fakeparagon.DefaultPassword); err != nil {
+		t.Fatalf("authenticating against the fake Paragon API: %v", err)
+	}
+	return c
+}
+
+// testAccProject creates a project for resources that need one to exist beforehand.
+func testAccProject(t *testing.T, server *fakeparagon.Server, title string) *client.Project {
+	t.Helper()
+
+	project, _, err := testAccClient(t, server).CreateProject(context.Background(), server.DefaultOrganizationID(), title)
+	if err != nil {
+		t.Fatalf("creating project: %v", err)
+	}
+	return project
+}
+
+// testAccStateAttr copies an attribute of a resource in the state to target, for use in later test steps.
+func testAccStateAttr(name, key string, target *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+		value, ok := rs.Primary.Attributes[key]
+		if !ok {
+			return fmt.Errorf("attribute %s not found on %s", key, name)
+		}
+		*target = value
+		return nil
+	}
+}
+
+// testAccImportStateID builds the import ID of a resource in the state by joining the given attributes with "/".
+func testAccImportStateID(name string, keys ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+		parts := make([]string, len(keys))
+		for i, key := range keys {
+			parts[i] = rs.Primary.Attributes[key]
+		}
+		return strings.Join(parts, "/"), nil
+	}
+}
+
+// testAccCheckDestroyed runs exists for every resource of the type left in the state after destroy, and fails
+// if any of them can still be found.
+func testAccCheckDestroyed(resourceType string, exists func(attributes map[string]string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			err := exists(rs.Primary.Attributes)
+			if err == nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+			if !errors.Is(err, client.ErrNotFound) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccProvider_accessToken(t *testing.T) {
+	server := testAccServer(t)
+	token := server.IssueToken(server.DefaultUserID())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "paragon" {
+  base_url     = %[1]q
+  access_token = %[2]q
+}
+
+data "paragon_organizations" "test" {}
+`, server.URL, token),
+				Check: resource.TestCheckResourceAttr("data.paragon_organizations.test", "organizations.#", "1"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalidCredentials(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "paragon" {
+  base_url = %[1]q
+  username = %[2]q
+  password = "wrong"
+}
+
+data "paragon_organizations" "test" {}
+`, server.URL, fakeparagon.DefaultEmail),
+				ExpectError: regexp.MustCompile(`Unable to Authenticate with Paragon`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_profile(t *testing.T) {
+	server := testAccServer(t)
+	token := server.IssueToken(server.DefaultUserID())
+
+	testCredentialsEnv(t, fmt.Sprintf(`{"ci": {"accessToken": %q, "baseUrl": %q}}`, token, server.URL))
+	t.Setenv(envProfile, "ci")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "paragon" {
+  profile = "missing"
+}
+
+data "paragon_organizations" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`profile 'missing' not found in the credentials file`),
+			},
+			{
+				// The credentials and base URL come from the profile named by PARAGON_PROFILE.
+				Config: `
+provider "paragon" {}
+
+data "paragon_organizations" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.paragon_organizations.test", "organizations.#", "1"),
+			},
+		},
+	})
+}
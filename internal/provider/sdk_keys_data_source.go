@@ -0,0 +1,161 @@
+// sdk_keys_data_source.go
+package provider
+
+import (
+    "context"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &sdkKeysDataSource{}
+    _ datasource.DataSourceWithConfigure = &sdkKeysDataSource{}
+)
+
+// NewSDKKeysDataSource is a helper function to simplify the provider implementation.
+func NewSDKKeysDataSource() datasource.DataSource {
+    return &sdkKeysDataSource{}
+}
+
+// sdkKeysDataSource is the data source implementation.
+type sdkKeysDataSource struct {
+    client *client.Client
+}
+
+// sdkKeysDataSourceModel maps the data source schema data.
+type sdkKeysDataSourceModel struct {
+    ProjectID types.String  `tfsdk:"project_id"`
+    Keys      []sdkKeyModel `tfsdk:"keys"`
+}
+
+type sdkKeyModel struct {
+    ID            types.String `tfsdk:"id"`
+    AuthType      types.String `tfsdk:"auth_type"`
+    Revoked       types.Bool   `tfsdk:"revoked"`
+    GeneratedDate types.String `tfsdk:"generated_date"`
+    PublicKey     types.String `tfsdk:"public_key"`
+    PublicKeyJWK  types.String `tfsdk:"public_key_jwk"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sdkKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *sdkKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_sdk_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *sdkKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the SDK keys of a project. Private keys are only returned when a key is generated, so only the public keys are listed.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "keys": schema.ListNestedAttribute{
+                Description: "The SDK keys of the project.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{
+                            Description: "Identifier of the SDK key.",
+                            Computed:    true,
+                        },
+                        "auth_type": schema.StringAttribute{
+                            Description: "Authentication type of the SDK key.",
+                            Computed:    true,
+                        },
+                        "revoked": schema.BoolAttribute{
+                            Description: "Indicates if the SDK key is revoked.",
+                            Computed:    true,
+                        },
+                        "generated_date": schema.StringAttribute{
+                            Description: "Date when the SDK key was generated.",
+                            Computed:    true,
+                        },
+                        "public_key": schema.StringAttribute{
+                            Description: "PEM encoded public key of the SDK key, null for keys without one.",
+                            Computed:    true,
+                        },
+                        "public_key_jwk": schema.StringAttribute{
+                            Description: "The public key as a JSON Web Key, with the ID of the SDK key as its key ID.",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sdkKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state sdkKeysDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    sdkKeys, err := d.client.GetSDKKeys(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read SDK Keys",
+            err.Error(),
+        )
+        return
+    }
+
+    state.Keys = make([]sdkKeyModel, 0, len(sdkKeys))
+    for _, sdkKey := range sdkKeys {
+        key := sdkKeyModel{
+            ID:            types.StringValue(sdkKey.ID),
+            AuthType:      types.StringValue(sdkKey.AuthType),
+            Revoked:       types.BoolValue(sdkKey.Revoked),
+            GeneratedDate: optionalString(sdkKey.AuthConfig.Paragon.GeneratedDate),
+            PublicKey:     optionalString(sdkKey.AuthConfig.Paragon.PublicKey),
+            PublicKeyJWK:  types.StringNull(),
+        }
+
+        if publicKeyPEM := sdkKey.AuthConfig.Paragon.PublicKey; publicKeyPEM != "" {
+            publicKey, err := parseRSAPublicKey(publicKeyPEM)
+            if err != nil {
+                resp.Diagnostics.AddError(
+                    "Unable to Read SDK Keys",
+                    "Could not parse the public key of SDK key "+sdkKey.ID+": "+err.Error(),
+                )
+                return
+            }
+            jwk, err := rsaPublicKeyJWK(sdkKey.ID, publicKey)
+            if err != nil {
+                resp.Diagnostics.AddError(
+                    "Unable to Read SDK Keys",
+                    "Could not encode the public key of SDK key "+sdkKey.ID+": "+err.Error(),
+                )
+                return
+            }
+            key.PublicKeyJWK = types.StringValue(jwk)
+        }
+
+        state.Keys = append(state.Keys, key)
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDKKeysDataSource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-sdk-keys-data")
+
+	key, err := c.CreateSDKKey(context.Background(), project.ID)
+	if err != nil {
+		t.Fatalf("creating SDK key: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSDKKeysResourceConfig(server, project.ID, "1") + fmt.Sprintf(`
+data "paragon_sdk_keys" "test" {
+  project_id = %[1]q
+
+  depends_on = [paragon_sdk_keys.test]
+}
+
+locals {
+  managed_key = one([for key in data.paragon_sdk_keys.test.keys : key if key.id == paragon_sdk_keys.test.id])
+}
+
+output "same_public_key" {
+  value = local.managed_key.public_key == paragon_sdk_keys.test.public_key
+}
+
+output "jwk_kid" {
+  value = jsondecode(local.managed_key.public_key_jwk).kid == paragon_sdk_keys.test.id
+}
+`, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_sdk_keys.test", "keys.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.paragon_sdk_keys.test", "keys.*", map[string]string{
+						"id":        key.ID,
+						"auth_type": "paragon",
+						"revoked":   "false",
+					}),
+					resource.TestMatchTypeSetElemNestedAttrs("data.paragon_sdk_keys.test", "keys.*", map[string]*regexp.Regexp{
+						"id":             regexp.MustCompile(`^` + regexp.QuoteMeta(key.ID) + `$`),
+						"public_key":     regexp.MustCompile(`^-----BEGIN PUBLIC KEY-----`),
+						"public_key_jwk": regexp.MustCompile(`"kty":"RSA","use":"sig","alg":"RS256","kid":"` + regexp.QuoteMeta(key.ID) + `"`),
+					}),
+					resource.TestCheckOutput("same_public_key", "true"),
+					resource.TestCheckOutput("jwk_kid", "true"),
+				),
+			},
+		},
+	})
+}
@@ -3,8 +3,9 @@ package provider
 
 import (
     "context"
-    "strings"
+    "errors"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,8 +18,13 @@ import (
 var (
     _ resource.Resource              = &sdkKeysResource{}
     _ resource.ResourceWithConfigure = &sdkKeysResource{}
+    _ resource.ResourceWithImportState = &sdkKeysResource{}
+    _ resource.ResourceWithModifyPlan  = &sdkKeysResource{}
 )
 
+// sdkKeysImportedKey is the private state key marking an imported key until it is first updated.
+const sdkKeysImportedKey = "imported"
+
 // NewSDKKeysResource is a helper function to simplify the provider implementation.
 func NewSDKKeysResource() resource.Resource {
     return &sdkKeysResource{}
@@ -37,7 +43,13 @@ type sdkKeysResourceModel struct {
     Revoked       types.Bool   `tfsdk:"revoked"`
     GeneratedDate types.String `tfsdk:"generated_date"`
     PrivateKey    types.String `tfsdk:"private_key"`
+    PublicKey     types.String `tfsdk:"public_key"`
     Version       types.String `tfsdk:"version"`
+    RotationKeepers    types.Map    `tfsdk:"rotation_keepers"`
+    KeepPrevious       types.Bool   `tfsdk:"keep_previous"`
+    PreviousKeyID      types.String `tfsdk:"previous_key_id"`
+    PreviousPrivateKey types.String `tfsdk:"previous_private_key"`
+    PreviousPublicKey  types.String `tfsdk:"previous_public_key"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -87,12 +99,37 @@ func (r *sdkKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, re
                 Computed:    true,
                 Sensitive:   true,
             },
+            "public_key": schema.StringAttribute{
+                Description: "PEM encoded public key of the SDK key, for verifying the tokens it signs.",
+                Computed:    true,
+            },
+            // Changes to version and rotation_keepers rotate the key, see ModifyPlan.
             "version": schema.StringAttribute{
-                Description: "Version of the SDK key.",
-                Required:    true,
-                PlanModifiers: []planmodifier.String{
-                    stringplanmodifier.RequiresReplace(),
-                },
+                Description: "Version of the SDK key. Changing it rotates the key.",
+                Optional:    true,
+            },
+            "rotation_keepers": schema.MapAttribute{
+                Description: "Arbitrary map of values that rotate the key when any of them changes, e.g. a rotation date.",
+                ElementType: types.StringType,
+                Optional:    true,
+            },
+            "keep_previous": schema.BoolAttribute{
+                Description: "Rotate the key in place: the new key is generated first and the previous key is kept until the next rotation. " +
+                    "Otherwise the key is deleted before a new one is generated. Default=false.",
+                Optional:    true,
+            },
+            "previous_key_id": schema.StringAttribute{
+                Description: "Identifier of the previous SDK key, kept until the next rotation when keep_previous is set.",
+                Computed:    true,
+            },
+            "previous_private_key": schema.StringAttribute{
+                Description: "Private key of the previous SDK key.",
+                Computed:    true,
+                Sensitive:   true,
+            },
+            "previous_public_key": schema.StringAttribute{
+                Description: "PEM encoded public key of the previous SDK key.",
+                Computed:    true,
             },
         },
     }
@@ -108,7 +145,6 @@ func (r *sdkKeysResource) Create(ctx context.Context, req resource.CreateRequest
     }
 
     projectID := plan.ProjectID.ValueString()
-    version := plan.Version.ValueString()
 
     // Create new SDK key
     sdkKey, err := r.client.CreateSDKKey(ctx, projectID)
@@ -121,12 +157,10 @@ func (r *sdkKeysResource) Create(ctx context.Context, req resource.CreateRequest
     }
 
     // Map response body to schema and populate Computed attribute values
-    plan.ID = types.StringValue(sdkKey.ID)
-    plan.AuthType = types.StringValue(sdkKey.AuthType)
-    plan.Revoked = types.BoolValue(sdkKey.Revoked)
-    plan.GeneratedDate = types.StringValue(sdkKey.AuthConfig.Paragon.GeneratedDate)
-    plan.PrivateKey = types.StringValue(sdkKey.PrivateKey)
-    plan.Version = types.StringValue(version)
+    plan.setGeneratedKey(sdkKey)
+    plan.PreviousKeyID = types.StringNull()
+    plan.PreviousPrivateKey = types.StringNull()
+    plan.PreviousPublicKey = types.StringNull()
 
     // Set state to fully populated data
     diags = resp.State.Set(ctx, plan)
@@ -136,6 +170,16 @@ func (r *sdkKeysResource) Create(ctx context.Context, req resource.CreateRequest
     }
 }
 
+// setGeneratedKey maps a newly generated SDK key, the only time its private key is returned, to the model.
+func (m *sdkKeysResourceModel) setGeneratedKey(sdkKey *client.SDKKey) {
+    m.ID = types.StringValue(sdkKey.ID)
+    m.AuthType = types.StringValue(sdkKey.AuthType)
+    m.Revoked = types.BoolValue(sdkKey.Revoked)
+    m.GeneratedDate = types.StringValue(sdkKey.AuthConfig.Paragon.GeneratedDate)
+    m.PrivateKey = types.StringValue(sdkKey.PrivateKey)
+    m.PublicKey = optionalString(sdkKey.AuthConfig.Paragon.PublicKey)
+}
+
 func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
     var state sdkKeysResourceModel
     diags := req.State.Get(ctx, &state)
@@ -150,7 +194,7 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
     sdkKeys, err := r.client.GetSDKKeys(ctx, projectID)
     if err != nil {
         // Check if the error indicates a 404 status code
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             // If the SDK key is not found, remove the resource to trigger recreation
             resp.State.RemoveResource(ctx)
             return
@@ -162,12 +206,15 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
         return
     }
 
-    // Find the SDK key with the matching ID
-    var sdkKey *client.SDKKey
+    // Find the SDK key with the matching ID, and the previous key if one is kept
+    var sdkKey, previousKey *client.SDKKey
     for _, key := range sdkKeys {
-        if key.ID == state.ID.ValueString() {
+        key := key
+        switch key.ID {
+        case state.ID.ValueString():
             sdkKey = &key
-            break
+        case state.PreviousKeyID.ValueString():
+            previousKey = &key
         }
     }
 
@@ -181,6 +228,16 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
     state.AuthType = types.StringValue(sdkKey.AuthType)
     state.Revoked = types.BoolValue(sdkKey.Revoked)
     state.GeneratedDate = types.StringValue(sdkKey.AuthConfig.Paragon.GeneratedDate)
+    state.PublicKey = optionalString(sdkKey.AuthConfig.Paragon.PublicKey)
+
+    // Forget the previous key if it was deleted outside of Terraform
+    if previousKey == nil {
+        state.PreviousKeyID = types.StringNull()
+        state.PreviousPrivateKey = types.StringNull()
+        state.PreviousPublicKey = types.StringNull()
+    } else {
+        state.PreviousPublicKey = optionalString(previousKey.AuthConfig.Paragon.PublicKey)
+    }
 
     // Set the refreshed state
     diags = resp.State.Set(ctx, &state)
@@ -190,7 +247,77 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
     }
 }
 
-// Update is not supported for this resource.
+// ModifyPlan plans the rotation of the key when version or rotation_keepers change, including when they are first
+// set. Without keep_previous the key is replaced, otherwise Update generates the new key in place and keeps the
+// current key as the previous key. Imported keys have neither, so setting them on a key just imported keeps it.
+func (r *sdkKeysResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to plan when the key is created or destroyed.
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var plan, state sdkKeysResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    imported, diags := req.Private.GetKey(ctx, sdkKeysImportedKey)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    versionChanged := !plan.Version.Equal(state.Version) && (imported == nil || !state.Version.IsNull())
+    keepersChanged := !plan.RotationKeepers.Equal(state.RotationKeepers) && (imported == nil || !state.RotationKeepers.IsNull())
+    rotate := versionChanged || keepersChanged
+
+    if rotate && !plan.KeepPrevious.ValueBool() {
+        if versionChanged {
+            resp.RequiresReplace = append(resp.RequiresReplace, path.Root("version"))
+        }
+        if keepersChanged {
+            resp.RequiresReplace = append(resp.RequiresReplace, path.Root("rotation_keepers"))
+        }
+        return
+    }
+
+    if rotate {
+        // A new key is generated, the current key becomes the previous key and the key kept before is deleted.
+        plan.ID = types.StringUnknown()
+        plan.AuthType = types.StringUnknown()
+        plan.Revoked = types.BoolUnknown()
+        plan.GeneratedDate = types.StringUnknown()
+        plan.PrivateKey = types.StringUnknown()
+        plan.PublicKey = types.StringUnknown()
+        plan.PreviousKeyID = state.ID
+        plan.PreviousPrivateKey = state.PrivateKey
+        plan.PreviousPublicKey = state.PublicKey
+    } else {
+        // The key is kept, and so is the previous key unless keep_previous was unset.
+        plan.ID = state.ID
+        plan.AuthType = state.AuthType
+        plan.Revoked = state.Revoked
+        plan.GeneratedDate = state.GeneratedDate
+        plan.PrivateKey = state.PrivateKey
+        plan.PublicKey = state.PublicKey
+        if plan.KeepPrevious.ValueBool() {
+            plan.PreviousKeyID = state.PreviousKeyID
+            plan.PreviousPrivateKey = state.PreviousPrivateKey
+            plan.PreviousPublicKey = state.PreviousPublicKey
+        } else {
+            plan.PreviousKeyID = types.StringNull()
+            plan.PreviousPrivateKey = types.StringNull()
+            plan.PreviousPublicKey = types.StringNull()
+        }
+    }
+
+    resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Update rotates the key in place when a new key is planned, see ModifyPlan, and deletes the previous key when it
+// is no longer kept. Otherwise only the arguments change.
 func (r *sdkKeysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
     // Retrieve values from plan
     var plan sdkKeysResourceModel
@@ -208,6 +335,38 @@ func (r *sdkKeysResource) Update(ctx context.Context, req resource.UpdateRequest
         return
     }
 
+    projectID := state.ProjectID.ValueString()
+
+    // Generate the new key first, so that the current key stays valid until the new one exists
+    if plan.ID.IsUnknown() {
+        sdkKey, err := r.client.CreateSDKKey(ctx, projectID)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Error rotating SDK key",
+                "Could not create SDK key, unexpected error: "+err.Error(),
+            )
+            return
+        }
+        plan.setGeneratedKey(sdkKey)
+    }
+
+    // Delete the key that was kept before, if it is no longer the previous key
+    if !state.PreviousKeyID.IsNull() && !state.PreviousKeyID.Equal(plan.PreviousKeyID) {
+        err := r.client.DeleteSDKKey(ctx, projectID, state.PreviousKeyID.ValueString())
+        if err != nil && !errors.Is(err, client.ErrNotFound) {
+            // Keep the new key in the state, it can't be generated again.
+            resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+            resp.Diagnostics.AddError(
+                "Error deleting previous SDK key",
+                "Could not delete previous SDK key "+state.PreviousKeyID.ValueString()+", unexpected error: "+err.Error(),
+            )
+            return
+        }
+    }
+
+    // Once updated, an imported key is rotated like any other
+    resp.Diagnostics.Append(resp.Private.SetKey(ctx, sdkKeysImportedKey, nil)...)
+
     // Set the state to the current plan
     diags = resp.State.Set(ctx, plan)
     resp.Diagnostics.Append(diags...)
@@ -216,7 +375,8 @@ func (r *sdkKeysResource) Update(ctx context.Context, req resource.UpdateRequest
     }
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
+// Delete deletes the resource, along with the previous key if one is kept, and removes the Terraform state on
+// success.
 func (r *sdkKeysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
     var state sdkKeysResourceModel
     diags := req.State.Get(ctx, &state)
@@ -226,19 +386,38 @@ func (r *sdkKeysResource) Delete(ctx context.Context, req resource.DeleteRequest
     }
 
     projectID := state.ProjectID.ValueString()
-    keyID := state.ID.ValueString()
+    keyIDs := []string{state.ID.ValueString()}
+    if !state.PreviousKeyID.IsNull() {
+        keyIDs = append(keyIDs, state.PreviousKeyID.ValueString())
+    }
 
-    // Delete the SDK key using the DeleteSDKKey function
-    err := r.client.DeleteSDKKey(ctx, projectID, keyID)
-    if err != nil {
-        // Check if the error message indicates a 404 Not Found status code
-        if strings.Contains(err.Error(), "status code: 404") {
+    for _, keyID := range keyIDs {
+        // Delete the SDK key using the DeleteSDKKey function
+        err := r.client.DeleteSDKKey(ctx, projectID, keyID)
+        if err != nil {
+            // Check if the error message indicates a 404 Not Found status code
+            if errors.Is(err, client.ErrNotFound) {
+                continue
+            }
+            resp.Diagnostics.AddError(
+                "Error deleting SDK key",
+                "Could not delete SDK key "+keyID+", unexpected error: "+err.Error(),
+            )
             return
         }
-        resp.Diagnostics.AddError(
-            "Error deleting SDK key",
-            "Could not delete SDK key, unexpected error: "+err.Error(),
-        )
+    }
+}
+
+// ImportState imports an existing SDK key by "project_id/key_id". The private key is only returned when the key
+// is generated, so it is empty for imported keys. The key is marked as imported so that setting version or
+// rotation_keepers doesn't rotate it, see ModifyPlan.
+func (r *sdkKeysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    projectID, keyID, ok := splitImportID(req, resp, "project_id/key_id")
+    if !ok {
         return
     }
-}
\ No newline at end of file
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+    resp.Diagnostics.Append(resp.Private.SetKey(ctx, sdkKeysImportedKey, []byte("true"))...)
+}
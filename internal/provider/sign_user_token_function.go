@@ -0,0 +1,135 @@
+// sign_user_token_function.go
+package provider
+
+import (
+    "context"
+    "fmt"
+    "slices"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/function"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &signUserTokenFunction{}
+
+// NewSignUserTokenFunction is a helper function to simplify the provider implementation.
+func NewSignUserTokenFunction() function.Function {
+    return &signUserTokenFunction{}
+}
+
+// signUserTokenFunction is the function implementation.
+type signUserTokenFunction struct{}
+
+// Metadata returns the function name.
+func (f *signUserTokenFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+    resp.Name = "sign_user_token"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *signUserTokenFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+    resp.Definition = function.Definition{
+        Summary: "Signs a Paragon Connect user token.",
+        MarkdownDescription: "Signs a Paragon Connect user token (an RS256 JWT) with the private key of an SDK key, " +
+            "without calling the Paragon API. Functions must return the same result when planning and applying, so " +
+            "the time the token is issued at is an argument, usually `plantimestamp()`.",
+        Parameters: []function.Parameter{
+            function.StringParameter{
+                Name:                "private_key",
+                MarkdownDescription: "PEM encoded RSA private key, e.g. the `private_key` of `paragon_sdk_keys`.",
+            },
+            function.StringParameter{
+                Name:                "project_id",
+                MarkdownDescription: "Identifier of the project, which the token is for.",
+            },
+            function.StringParameter{
+                Name:                "user_id",
+                MarkdownDescription: "Identifier of the user in your application, the subject of the token.",
+            },
+            function.StringParameter{
+                Name:                "issued_at",
+                MarkdownDescription: "When the token is issued, in RFC 3339 format.",
+            },
+            function.StringParameter{
+                Name:                "ttl",
+                MarkdownDescription: "How long the token is valid for after it is issued, e.g. `1h`.",
+            },
+            function.DynamicParameter{
+                Name:                "extra_claims",
+                AllowNullValue:      true,
+                MarkdownDescription: "Object of additional claims, e.g. `{ meta = { Email = \"user@example.com\" } }`, or null.",
+            },
+        },
+        Return: function.StringReturn{},
+    }
+}
+
+// Run signs the token.
+func (f *signUserTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+    var privateKeyPEM, projectID, userID, issuedAt, ttl string
+    var extraClaims types.Dynamic
+
+    resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &privateKeyPEM, &projectID, &userID, &issuedAt, &ttl, &extraClaims))
+    if resp.Error != nil {
+        return
+    }
+
+    privateKey, err := parseRSAPrivateKey(privateKeyPEM)
+    if err != nil {
+        resp.Error = function.NewArgumentFuncError(0, "Invalid private key: "+err.Error())
+        return
+    }
+    if projectID == "" {
+        resp.Error = function.NewArgumentFuncError(1, "The project ID must not be empty.")
+        return
+    }
+    if userID == "" {
+        resp.Error = function.NewArgumentFuncError(2, "The user ID must not be empty.")
+        return
+    }
+    issued, err := time.Parse(time.RFC3339, issuedAt)
+    if err != nil {
+        resp.Error = function.NewArgumentFuncError(3, "Invalid issued_at, expected an RFC 3339 timestamp: "+err.Error())
+        return
+    }
+    validFor, err := time.ParseDuration(ttl)
+    if err != nil || validFor <= 0 {
+        resp.Error = function.NewArgumentFuncError(4, fmt.Sprintf("Invalid ttl %q, expected a positive duration such as \"1h\".", ttl))
+        return
+    }
+
+    claims := map[string]interface{}{}
+    if !extraClaims.IsNull() && !extraClaims.IsUnderlyingValueNull() {
+        extra, err := valueToJSON(ctx, extraClaims)
+        if err != nil {
+            resp.Error = function.NewArgumentFuncError(5, "Invalid extra claims: "+err.Error())
+            return
+        }
+        extraObject, ok := extra.(map[string]interface{})
+        if !ok {
+            resp.Error = function.NewArgumentFuncError(5, "The extra claims must be an object.")
+            return
+        }
+        for name, value := range extraObject {
+            if slices.Contains(reservedUserTokenClaims, name) {
+                resp.Error = function.NewArgumentFuncError(5, fmt.Sprintf("The %q claim is set from the other arguments and can't be an extra claim.", name))
+                return
+            }
+            claims[name] = value
+        }
+    }
+
+    claims["sub"] = userID
+    claims["aud"] = userTokenAudience(projectID)
+    claims["iat"] = issued.Unix()
+    claims["exp"] = issued.Add(validFor).Unix()
+
+    token, err := signUserToken(privateKey, claims)
+    if err != nil {
+        resp.Error = function.NewFuncError("Could not sign the user token: " + err.Error())
+        return
+    }
+
+    resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, token))
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSignUserTokenFunction(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-sign-user-token")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Tokens signed with the private key of an SDK key verify with its public key.
+				Config: testAccSDKKeysResourceConfig(server, project.ID, "1") + fmt.Sprintf(`
+locals {
+  token = provider::paragon::sign_user_token(
+    paragon_sdk_keys.test.private_key,
+    %[1]q,
+    "user-1",
+    "2024-01-01T00:00:00Z",
+    "1h",
+    { meta = { Email = "user@example.com", Seats = 3 } },
+  )
+}
+
+output "claims" {
+  sensitive = true
+  value     = provider::paragon::verify_user_token(paragon_sdk_keys.test.public_key, local.token)
+}
+
+output "deterministic" {
+  sensitive = true
+  value     = provider::paragon::sign_user_token(
+    paragon_sdk_keys.test.private_key,
+    %[1]q,
+    "user-1",
+    "2024-01-01T00:00:00Z",
+    "1h",
+    { meta = { Seats = 3, Email = "user@example.com" } },
+  ) == local.token
+}
+`, project.ID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("claims", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"sub": knownvalue.StringExact("user-1"),
+						"aud": knownvalue.StringExact("useparagon.com/" + project.ID),
+						"iat": knownvalue.Int64Exact(1704067200),
+						"exp": knownvalue.Int64Exact(1704070800),
+						"meta": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"Email": knownvalue.StringExact("user@example.com"),
+							"Seats": knownvalue.Int64Exact(3),
+						}),
+					})),
+					statecheck.ExpectKnownOutputValue("deterministic", knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSignUserTokenFunction_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "token" {
+  value = provider::paragon::sign_user_token("not a key", "project", "user", "2024-01-01T00:00:00Z", "1h", null)
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid private key`),
+			},
+			{
+				Config: `
+output "token" {
+  value = provider::paragon::verify_user_token("not a key", "token")
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid public key`),
+			},
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-team")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_team" "test" {
+  name = "acc-team"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_team.test", "id", project.TeamID),
+					resource.TestCheckResourceAttr("data.paragon_team.test", "organization_id", server.DefaultOrganizationID()),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_team" "test" {
+  name = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`Team with name 'missing' not found`),
+			},
+		},
+	})
+}
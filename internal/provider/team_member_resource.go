@@ -2,9 +2,9 @@ package provider
 
 import (
     "context"
+    "errors"
     "fmt"
     "regexp"
-    "strings"
 
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,6 +22,7 @@ import (
 var (
     _ resource.Resource              = &teamMemberResource{}
     _ resource.ResourceWithConfigure = &teamMemberResource{}
+    _ resource.ResourceWithImportState = &teamMemberResource{}
 )
 
 // NewTeamMemberResource is a helper function to simplify the provider implementation.
@@ -197,7 +198,7 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
     tflog.Debug(ctx, "Getting team members...")
     members, err := r.client.GetTeamMembers(ctx, teamID)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.State.RemoveResource(ctx)
             return
         }
@@ -230,7 +231,7 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
     tflog.Debug(ctx, "Searching invites...")
     invites, err := r.client.GetTeamInvites(ctx, teamID)
     if err != nil {
-        if strings.Contains(err.Error(), "status code: 404") {
+        if errors.Is(err, client.ErrNotFound) {
             resp.State.RemoveResource(ctx)
             return
         }
@@ -246,6 +247,7 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
             tflog.Debug(ctx, "Found member email in invites! All good.")
 
             // Map the invite data to the state
+            state.ID = types.StringValue(invite.ID)
             state.Role = types.StringValue(invite.Role)
 
             // Set the refreshed state
@@ -361,7 +363,7 @@ func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
     // Delete the team member from the members list
     err := r.client.DeleteTeamMember(ctx, teamID, memberID)
     if err != nil {
-        if err.Error() != "status code: 404" {
+        if !errors.Is(err, client.ErrNotFound) {
             resp.Diagnostics.AddError(
                "Error deleting team member",
                 fmt.Sprintf("Could not delete team member from members list, unexpected error: %s\nTeam ID: %s\nMember ID: %s", err.Error(), teamID, memberID),
@@ -372,7 +374,7 @@ func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
         // If the member is not found in the members list, try deleting from the invites
         err = r.client.DeleteTeamInvite(ctx, teamID, memberID)
         if err != nil {
-            if err.Error() != "status code: 404"  {
+            if !errors.Is(err, client.ErrNotFound) {
                 resp.Diagnostics.AddError(
                     "Error deleting team invite",
                     "Could not delete team invite, unexpected error: "+err.Error(),
@@ -384,4 +386,16 @@ func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 
     // Remove the resource from the state
     resp.State.RemoveResource(ctx)
-}
\ No newline at end of file
+}
+
+// ImportState imports an existing team member or pending invite by "team_id/email".
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    teamID, email, ok := splitImportID(req, resp, "team_id/email")
+    if !ok {
+        return
+    }
+
+    // Read finds the member, or the invite, by email
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/client"
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+const testAccTeamMemberEmail = "member@example.com"
+
+func testAccTeamMemberResourceConfig(server *fakeparagon.Server, teamID, role string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_team_member" "test" {
+  team_id = %[1]q
+  email   = %[2]q
+  role    = %[3]q
+}
+`, teamID, testAccTeamMemberEmail, role)
+}
+
+func TestAccTeamMemberResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+	project := testAccProject(t, server, "acc-team-member")
+
+	var memberID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_team_member", func(attributes map[string]string) error {
+			ctx := context.Background()
+			members, err := c.GetTeamMembers(ctx, attributes["team_id"])
+			if err != nil {
+				return err
+			}
+			invites, err := c.GetTeamInvites(ctx, attributes["team_id"])
+			if err != nil {
+				return err
+			}
+			for _, member := range members {
+				if member.Email == attributes["email"] {
+					return nil
+				}
+			}
+			for _, invite := range invites {
+				if invite.Email == attributes["email"] {
+					return nil
+				}
+			}
+			return client.ErrNotFound
+		}),
+		Steps: []resource.TestStep{
+			{
+				// New members are invited first.
+				Config: testAccTeamMemberResourceConfig(server, project.TeamID, "MEMBER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_team_member.test", "role", "MEMBER"),
+					resource.TestCheckResourceAttrSet("paragon_team_member.test", "id"),
+				),
+			},
+			{
+				// Once the invite is accepted, the role of the member can be changed.
+				PreConfig: func() {
+					if !server.AcceptInvite(project.TeamID, testAccTeamMemberEmail) {
+						t.Fatal("expected a pending invite")
+					}
+				},
+				Config: testAccTeamMemberResourceConfig(server, project.TeamID, "ADMIN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_team_member.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_team_member.test", "role", "ADMIN"),
+					testAccStateAttr("paragon_team_member.test", "id", &memberID),
+				),
+			},
+			{
+				ResourceName:      "paragon_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateID("paragon_team_member.test", "team_id", "email"),
+			},
+			{
+				PreConfig: func() {
+					if err := c.DeleteTeamMember(context.Background(), project.TeamID, memberID); err != nil {
+						t.Fatalf("deleting team member: %v", err)
+					}
+				},
+				Config: testAccTeamMemberResourceConfig(server, project.TeamID, "ADMIN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_team_member.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,223 @@
+// team_resource.go
+package provider
+
+import (
+    "context"
+    "errors"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource                = &teamResource{}
+    _ resource.ResourceWithConfigure   = &teamResource{}
+    _ resource.ResourceWithImportState = &teamResource{}
+)
+
+// NewTeamResource is a helper function to simplify the provider implementation.
+func NewTeamResource() resource.Resource {
+    return &teamResource{}
+}
+
+// teamResource is the resource implementation.
+type teamResource struct {
+    client *client.Client
+}
+
+// teamResourceModel maps the resource schema data.
+type teamResourceModel struct {
+    ID             types.String `tfsdk:"id"`
+    OrganizationID types.String `tfsdk:"organization_id"`
+    Name           types.String `tfsdk:"name"`
+    Website        types.String `tfsdk:"website"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a team.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the team.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "organization_id": schema.StringAttribute{
+                Description: "Identifier of the organization.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "name": schema.StringAttribute{
+                Description: "Name of the team.",
+                Required:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "website": schema.StringAttribute{
+                Description: "Website of the team.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+        },
+    }
+}
+
+// mapTeamToResourceModel sets the state from the team returned by the API.
+func mapTeamToResourceModel(team *client.Team, state *teamResourceModel) {
+    state.ID = types.StringValue(team.ID)
+    state.OrganizationID = types.StringValue(team.OrganizationID)
+    state.Name = types.StringValue(team.Name)
+    state.Website = optionalString(team.Website)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan teamResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    team, err := r.client.CreateTeam(ctx, plan.OrganizationID.ValueString(), plan.Name.ValueString(), plan.Website.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error creating team",
+            "Could not create team, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Map response body to schema and populate Computed attribute values
+    mapTeamToResourceModel(team, &plan)
+
+    // Set state to fully populated data
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state teamResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    team, err := r.client.GetTeamByID(ctx, state.ID.ValueString())
+    if err != nil {
+        if errors.Is(err, client.ErrNotFound) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error reading team",
+            "Could not read team, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Update the state with the latest data
+    mapTeamToResourceModel(team, &state)
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan teamResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state teamResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    team, err := r.client.UpdateTeam(ctx, state.ID.ValueString(), plan.Name.ValueString(), plan.Website.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating team",
+            "Could not update team, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Update the state with the updated data
+    mapTeamToResourceModel(team, &plan)
+
+    // Set the updated state
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state teamResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    err := r.client.DeleteTeam(ctx, state.ID.ValueString())
+    if err != nil && !errors.Is(err, client.ErrNotFound) {
+        resp.Diagnostics.AddError(
+            "Error deleting team",
+            "Could not delete team, unexpected error: "+err.Error(),
+        )
+        return
+    }
+}
+
+// ImportState imports an existing team by its identifier.
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/arielb135/terraform-provider-paragon/internal/fakeparagon"
+)
+
+// testAccTeamResourceConfig configures a team, without a website when website is empty.
+func testAccTeamResourceConfig(server *fakeparagon.Server, name, website string) string {
+	websiteConfig := ""
+	if website != "" {
+		websiteConfig = fmt.Sprintf("website         = %q", website)
+	}
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_team" "test" {
+  organization_id = %[1]q
+  name            = %[2]q
+  %[3]s
+}
+`, server.DefaultOrganizationID(), name, websiteConfig)
+}
+
+func TestAccTeamResource(t *testing.T) {
+	server := testAccServer(t)
+	c := testAccClient(t, server)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: testAccCheckDestroyed("paragon_team", func(attributes map[string]string) error {
+			_, err := c.GetTeamByID(context.Background(), attributes["id"])
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfig(server, "acc-team", "https://example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("paragon_team.test", "id"),
+					resource.TestCheckResourceAttr("paragon_team.test", "organization_id", server.DefaultOrganizationID()),
+					resource.TestCheckResourceAttr("paragon_team.test", "name", "acc-team"),
+					resource.TestCheckResourceAttr("paragon_team.test", "website", "https://example.com"),
+				),
+			},
+			{
+				// Teams are renamed in place.
+				Config: testAccTeamResourceConfig(server, "acc-team-renamed", "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paragon_team.test", "name", "acc-team-renamed"),
+					resource.TestCheckResourceAttr("paragon_team.test", "website", "https://example.org"),
+				),
+			},
+			{
+				ResourceName:      "paragon_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_team" "test" {
+  organization_id = %[1]q
+  name            = "acc-team-renamed"
+  website         = ""
+}
+`, server.DefaultOrganizationID()),
+				ExpectError: regexp.MustCompile(`Attribute website string length must be at least 1`),
+			},
+			{
+				// Removing the website from the configuration removes it from the team.
+				Config: testAccTeamResourceConfig(server, "acc-team-renamed", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("paragon_team.test", "website"),
+					func(state *terraform.State) error {
+						team, err := testAccClient(t, server).GetTeamByID(context.Background(), state.RootModule().Resources["paragon_team.test"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						if team.Website != "" {
+							return fmt.Errorf("expected the website to be removed, got %q", team.Website)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A team deleted outside of Terraform is created again.
+				PreConfig: func() {
+					teams, err := c.GetTeams(context.Background())
+					if err != nil {
+						t.Fatalf("listing teams: %v", err)
+					}
+					for _, team := range teams {
+						if team.Name == "acc-team-renamed" {
+							if err := c.DeleteTeam(context.Background(), team.ID); err != nil {
+								t.Fatalf("deleting team: %v", err)
+							}
+						}
+					}
+				},
+				Config: testAccTeamResourceConfig(server, "acc-team-renamed", "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("paragon_team.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccTeamResource_projectsAndMembers(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Projects and members can be added to the team, and are removed before it on destroy.
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "paragon_team" "test" {
+  organization_id = %[1]q
+  name            = "acc-team-customer"
+}
+
+resource "paragon_project" "test" {
+  organization_id = paragon_team.test.organization_id
+  team_id         = paragon_team.test.id
+  title           = "acc-team-customer-project"
+}
+
+resource "paragon_team_member" "test" {
+  team_id = paragon_team.test.id
+  email   = %[2]q
+  role    = "MEMBER"
+}
+`, server.DefaultOrganizationID(), testAccTeamMemberEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("paragon_team.test", "website"),
+					resource.TestCheckResourceAttrPair("paragon_project.test", "team_id", "paragon_team.test", "id"),
+					resource.TestCheckResourceAttr("paragon_project.test", "automate_project_id", ""),
+					resource.TestCheckResourceAttrPair("paragon_team_member.test", "team_id", "paragon_team.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -67,38 +67,54 @@ func (d *teamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
                 Description: "The list of teams.",
                 Computed:    true,
                 NestedObject: schema.NestedAttributeObject{
-                    Attributes: map[string]schema.Attribute{
-                        "id": schema.StringAttribute{
-                            Description: "Identifier for the team.",
-                            Computed:    true,
-                        },
-                        "date_created": schema.StringAttribute{
-                            Description: "The creation date of the team.",
-                            Computed:    true,
-                        },
-                        "date_updated": schema.StringAttribute{
-                            Description: "The last update date of the team.",
-                            Computed:    true,
-                        },
-                        "name": schema.StringAttribute{
-                            Description: "The name of the team.",
-                            Computed:    true,
-                        },
-                        "website": schema.StringAttribute{
-                            Description: "The website of the team.",
-                            Computed:    true,
-                        },
-                        "organization_id": schema.StringAttribute{
-                            Description: "The ID of the organization the team belongs to.",
-                            Computed:    true,
-                        },
-                    },
+                    Attributes: teamAttributes(),
                 },
             },
         },
     }
 }
 
+// teamAttributes returns the schema attributes of a team, shared by the data sources that list teams.
+func teamAttributes() map[string]schema.Attribute {
+    return map[string]schema.Attribute{
+        "id": schema.StringAttribute{
+            Description: "Identifier for the team.",
+            Computed:    true,
+        },
+        "date_created": schema.StringAttribute{
+            Description: "The creation date of the team.",
+            Computed:    true,
+        },
+        "date_updated": schema.StringAttribute{
+            Description: "The last update date of the team.",
+            Computed:    true,
+        },
+        "name": schema.StringAttribute{
+            Description: "The name of the team.",
+            Computed:    true,
+        },
+        "website": schema.StringAttribute{
+            Description: "The website of the team.",
+            Computed:    true,
+        },
+        "organization_id": schema.StringAttribute{
+            Description: "The ID of the organization the team belongs to.",
+            Computed:    true,
+        },
+    }
+}
+
+func mapTeamToModel(team client.Team) teamModel {
+    return teamModel{
+        ID:             types.StringValue(team.ID),
+        DateCreated:    types.StringValue(team.DateCreated),
+        DateUpdated:    types.StringValue(team.DateUpdated),
+        Name:           types.StringValue(team.Name),
+        Website:        types.StringValue(team.Website),
+        OrganizationID: types.StringValue(team.OrganizationID),
+    }
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
     var state teamsDataSourceModel
@@ -114,14 +130,7 @@ func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
     var teamModels []teamModel
     for _, team := range teams {
-        teamModels = append(teamModels, teamModel{
-            ID:             types.StringValue(team.ID),
-            DateCreated:    types.StringValue(team.DateCreated),
-            DateUpdated:    types.StringValue(team.DateUpdated),
-            Name:           types.StringValue(team.Name),
-            Website:        types.StringValue(team.Website),
-            OrganizationID: types.StringValue(team.OrganizationID),
-        })
+        teamModels = append(teamModels, mapTeamToModel(team))
     }
 
     state.Teams = teamModels
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-teams")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "paragon_teams" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_teams.test", "teams.#", "1"),
+					resource.TestCheckResourceAttr("data.paragon_teams.test", "teams.0.id", project.TeamID),
+					resource.TestCheckResourceAttr("data.paragon_teams.test", "teams.0.name", "acc-teams"),
+					resource.TestCheckResourceAttr("data.paragon_teams.test", "teams.0.organization_id", server.DefaultOrganizationID()),
+				),
+			},
+		},
+	})
+}
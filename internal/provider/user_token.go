@@ -0,0 +1,174 @@
+// user_token.go
+package provider
+
+import (
+    "crypto"
+    "crypto/rand"
+    "crypto/rsa"
+    "crypto/sha256"
+    "crypto/x509"
+    "encoding/base64"
+    "encoding/json"
+    "encoding/pem"
+    "fmt"
+    "math/big"
+    "strings"
+)
+
+// Paragon Connect user tokens are RS256 JWTs signed with the private key of an SDK key of the project. The
+// user is the subject of the token and the project is its audience.
+
+// userTokenAudience returns the audience of user tokens of a project.
+func userTokenAudience(projectID string) string {
+    return "useparagon.com/" + projectID
+}
+
+// reservedUserTokenClaims are set from the arguments of sign_user_token and can't be given as extra claims.
+var reservedUserTokenClaims = []string{"sub", "aud", "iat", "exp"}
+
+var userTokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+
+// signUserToken signs the claims with the private key. PKCS #1 v1.5 signatures are deterministic, so the same
+// claims and key always give the same token.
+func signUserToken(privateKey *rsa.PrivateKey, claims map[string]interface{}) (string, error) {
+    payload, err := json.Marshal(claims)
+    if err != nil {
+        return "", err
+    }
+
+    signingInput := userTokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+    digest := sha256.Sum256([]byte(signingInput))
+    signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+    if err != nil {
+        return "", err
+    }
+
+    return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// verifyUserToken checks the RS256 signature of the token and returns its claims. The expiry is not checked.
+func verifyUserToken(publicKey *rsa.PublicKey, token string) (map[string]interface{}, error) {
+    parts := strings.Split(token, ".")
+    if len(parts) != 3 {
+        return nil, fmt.Errorf("the token is not a JWT")
+    }
+
+    headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+    if err != nil {
+        return nil, fmt.Errorf("could not decode the token header: %w", err)
+    }
+    var header struct {
+        Algorithm string `json:"alg"`
+    }
+    if err := json.Unmarshal(headerJSON, &header); err != nil {
+        return nil, fmt.Errorf("could not decode the token header: %w", err)
+    }
+    if header.Algorithm != "RS256" {
+        return nil, fmt.Errorf("the token is signed with %q, expected RS256", header.Algorithm)
+    }
+
+    signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+    if err != nil {
+        return nil, fmt.Errorf("could not decode the token signature: %w", err)
+    }
+    digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+    if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature); err != nil {
+        return nil, fmt.Errorf("the token signature is invalid")
+    }
+
+    payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+    if err != nil {
+        return nil, fmt.Errorf("could not decode the token claims: %w", err)
+    }
+    decoder := json.NewDecoder(strings.NewReader(string(payload)))
+    decoder.UseNumber()
+    var claims map[string]interface{}
+    if err := decoder.Decode(&claims); err != nil {
+        return nil, fmt.Errorf("could not decode the token claims: %w", err)
+    }
+
+    return claims, nil
+}
+
+// parseRSAPrivateKey parses a PEM encoded RSA private key, in PKCS #8 like the keys of paragon_sdk_keys, or PKCS #1.
+func parseRSAPrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+    block, _ := pem.Decode([]byte(privateKeyPEM))
+    if block == nil {
+        return nil, fmt.Errorf("the private key is not PEM encoded")
+    }
+
+    switch block.Type {
+    case "PRIVATE KEY":
+        key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+        if err != nil {
+            return nil, err
+        }
+        rsaKey, ok := key.(*rsa.PrivateKey)
+        if !ok {
+            return nil, fmt.Errorf("the private key is not an RSA key")
+        }
+        return rsaKey, nil
+    case "RSA PRIVATE KEY":
+        return x509.ParsePKCS1PrivateKey(block.Bytes)
+    default:
+        return nil, fmt.Errorf("unexpected PEM block %q, expected a private key", block.Type)
+    }
+}
+
+// parseRSAPublicKey parses a PEM encoded RSA public key, in PKIX like the public keys of SDK keys, or PKCS #1.
+// The public key of a private key is accepted as well.
+func parseRSAPublicKey(publicKeyPEM string) (*rsa.PublicKey, error) {
+    block, _ := pem.Decode([]byte(publicKeyPEM))
+    if block == nil {
+        return nil, fmt.Errorf("the public key is not PEM encoded")
+    }
+
+    switch block.Type {
+    case "PUBLIC KEY":
+        key, err := x509.ParsePKIXPublicKey(block.Bytes)
+        if err != nil {
+            return nil, err
+        }
+        rsaKey, ok := key.(*rsa.PublicKey)
+        if !ok {
+            return nil, fmt.Errorf("the public key is not an RSA key")
+        }
+        return rsaKey, nil
+    case "RSA PUBLIC KEY":
+        return x509.ParsePKCS1PublicKey(block.Bytes)
+    case "PRIVATE KEY", "RSA PRIVATE KEY":
+        privateKey, err := parseRSAPrivateKey(publicKeyPEM)
+        if err != nil {
+            return nil, err
+        }
+        return &privateKey.PublicKey, nil
+    default:
+        return nil, fmt.Errorf("unexpected PEM block %q, expected a public key", block.Type)
+    }
+}
+
+// rsaPublicKeyJWK encodes an RSA public key as a JSON Web Key (RFC 7517) for verifying RS256 user tokens, with
+// the ID of the SDK key as its key ID.
+func rsaPublicKeyJWK(keyID string, publicKey *rsa.PublicKey) (string, error) {
+    jwk := struct {
+        KeyType   string `json:"kty"`
+        Use       string `json:"use"`
+        Algorithm string `json:"alg"`
+        KeyID     string `json:"kid"`
+        Modulus   string `json:"n"`
+        Exponent  string `json:"e"`
+    }{
+        KeyType:   "RSA",
+        Use:       "sig",
+        Algorithm: "RS256",
+        KeyID:     keyID,
+        Modulus:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+        Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+    }
+
+    encoded, err := json.Marshal(jwk)
+    if err != nil {
+        return "", err
+    }
+    return string(encoded), nil
+}
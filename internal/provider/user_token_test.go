@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestUserTokenSignature(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	token, err := signUserToken(privateKey, map[string]interface{}{"sub": "user-1"})
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+
+	claims, err := verifyUserToken(&privateKey.PublicKey, token)
+	if err != nil {
+		t.Fatalf("verifying token: %v", err)
+	}
+	if claims["sub"] != "user-1" {
+		t.Errorf("expected subject user-1, got %v", claims["sub"])
+	}
+
+	if _, err := verifyUserToken(&otherKey.PublicKey, token); err == nil {
+		t.Error("expected a token signed with another key to be rejected")
+	}
+
+	parts := strings.Split(token, ".")
+	tampered, err := signUserToken(privateKey, map[string]interface{}{"sub": "user-2"})
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	parts[1] = strings.Split(tampered, ".")[1]
+	if _, err := verifyUserToken(&privateKey.PublicKey, strings.Join(parts, ".")); err == nil {
+		t.Error("expected a token with modified claims to be rejected")
+	}
+}
+
+func TestParseRSAKeys(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("encoding key: %v", err)
+	}
+	pkix, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatalf("encoding key: %v", err)
+	}
+
+	privateKeys := map[string]string{
+		"PKCS #8": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+		"PKCS #1": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+	}
+	for name, encoded := range privateKeys {
+		parsed, err := parseRSAPrivateKey(encoded)
+		if err != nil {
+			t.Errorf("%s: parsing private key: %v", name, err)
+		} else if !parsed.Equal(privateKey) {
+			t.Errorf("%s: parsed another private key", name)
+		}
+	}
+
+	publicKeys := map[string]string{
+		"PKIX":        string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})),
+		"PKCS #1":     string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)})),
+		"private key": privateKeys["PKCS #8"],
+	}
+	for name, encoded := range publicKeys {
+		parsed, err := parseRSAPublicKey(encoded)
+		if err != nil {
+			t.Errorf("%s: parsing public key: %v", name, err)
+		} else if !parsed.Equal(&privateKey.PublicKey) {
+			t.Errorf("%s: parsed another public key", name)
+		}
+	}
+}
+
+func TestRSAPublicKeyJWK(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	encoded, err := rsaPublicKeyJWK("key-1", &privateKey.PublicKey)
+	if err != nil {
+		t.Fatalf("encoding key: %v", err)
+	}
+
+	var jwk map[string]string
+	if err := json.Unmarshal([]byte(encoded), &jwk); err != nil {
+		t.Fatalf("decoding JWK: %v", err)
+	}
+	if jwk["kty"] != "RSA" || jwk["alg"] != "RS256" || jwk["use"] != "sig" || jwk["kid"] != "key-1" {
+		t.Errorf("unexpected JWK header fields: %v", jwk)
+	}
+	if jwk["e"] != "AQAB" {
+		t.Errorf("expected exponent AQAB, got %q", jwk["e"])
+	}
+	modulus, err := base64.RawURLEncoding.DecodeString(jwk["n"])
+	if err != nil {
+		t.Fatalf("decoding modulus: %v", err)
+	}
+	if new(big.Int).SetBytes(modulus).Cmp(privateKey.N) != 0 {
+		t.Error("the JWK modulus doesn't match the key")
+	}
+}
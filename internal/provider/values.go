@@ -0,0 +1,138 @@
+// values.go
+package provider
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "math/big"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// optionalString maps an empty string returned by the API to an unset optional attribute.
+func optionalString(value string) types.String {
+    if value == "" {
+        return types.StringNull()
+    }
+    return types.StringValue(value)
+}
+
+// valueToJSON converts a Terraform value to the value encoding/json marshals it from. Numbers are kept exact.
+func valueToJSON(ctx context.Context, value attr.Value) (interface{}, error) {
+    if value.IsNull() {
+        return nil, nil
+    }
+    if value.IsUnknown() {
+        return nil, fmt.Errorf("the value is unknown")
+    }
+
+    switch v := value.(type) {
+    case basetypes.DynamicValue:
+        return valueToJSON(ctx, v.UnderlyingValue())
+    case basetypes.StringValue:
+        return v.ValueString(), nil
+    case basetypes.BoolValue:
+        return v.ValueBool(), nil
+    case basetypes.NumberValue:
+        return json.Number(v.ValueBigFloat().Text('f', -1)), nil
+    case basetypes.Int64Value:
+        return v.ValueInt64(), nil
+    case basetypes.Float64Value:
+        return v.ValueFloat64(), nil
+    case basetypes.ObjectValue:
+        return attributesToJSON(ctx, v.Attributes())
+    case basetypes.MapValue:
+        return attributesToJSON(ctx, v.Elements())
+    case basetypes.ListValue:
+        return elementsToJSON(ctx, v.Elements())
+    case basetypes.SetValue:
+        return elementsToJSON(ctx, v.Elements())
+    case basetypes.TupleValue:
+        return elementsToJSON(ctx, v.Elements())
+    default:
+        return nil, fmt.Errorf("unsupported value of type %s", value.Type(ctx))
+    }
+}
+
+func attributesToJSON(ctx context.Context, attributes map[string]attr.Value) (map[string]interface{}, error) {
+    result := make(map[string]interface{}, len(attributes))
+    for name, value := range attributes {
+        converted, err := valueToJSON(ctx, value)
+        if err != nil {
+            return nil, fmt.Errorf("%s: %w", name, err)
+        }
+        result[name] = converted
+    }
+    return result, nil
+}
+
+func elementsToJSON(ctx context.Context, elements []attr.Value) ([]interface{}, error) {
+    result := make([]interface{}, 0, len(elements))
+    for i, value := range elements {
+        converted, err := valueToJSON(ctx, value)
+        if err != nil {
+            return nil, fmt.Errorf("[%d]: %w", i, err)
+        }
+        result = append(result, converted)
+    }
+    return result, nil
+}
+
+// jsonToValue converts a value decoded by encoding/json, with numbers as json.Number or float64, to a Terraform value.
+// Objects become objects and arrays tuples, so that every element keeps its own type. JSON nulls become null
+// strings, as Terraform values always have a type.
+func jsonToValue(value interface{}) (attr.Value, error) {
+    switch v := value.(type) {
+    case nil:
+        return types.StringNull(), nil
+    case string:
+        return types.StringValue(v), nil
+    case bool:
+        return types.BoolValue(v), nil
+    case json.Number:
+        number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+        if err != nil {
+            return nil, err
+        }
+        return types.NumberValue(number), nil
+    case float64:
+        return types.NumberValue(big.NewFloat(v)), nil
+    case map[string]interface{}:
+        attributeTypes := make(map[string]attr.Type, len(v))
+        attributes := make(map[string]attr.Value, len(v))
+        for name, element := range v {
+            converted, err := jsonToValue(element)
+            if err != nil {
+                return nil, err
+            }
+            attributeTypes[name] = converted.Type(context.Background())
+            attributes[name] = converted
+        }
+        object, diags := types.ObjectValue(attributeTypes, attributes)
+        if diags.HasError() {
+            return nil, fmt.Errorf("could not convert object")
+        }
+        return object, nil
+    case []interface{}:
+        elementTypes := make([]attr.Type, 0, len(v))
+        elements := make([]attr.Value, 0, len(v))
+        for _, element := range v {
+            converted, err := jsonToValue(element)
+            if err != nil {
+                return nil, err
+            }
+            elementTypes = append(elementTypes, converted.Type(context.Background()))
+            elements = append(elements, converted)
+        }
+        tuple, diags := types.TupleValue(elementTypes, elements)
+        if diags.HasError() {
+            return nil, fmt.Errorf("could not convert array")
+        }
+        return tuple, nil
+    default:
+        return nil, fmt.Errorf("unsupported JSON value %T", value)
+    }
+}
@@ -0,0 +1,77 @@
+// verify_user_token_function.go
+package provider
+
+import (
+    "context"
+
+    "github.com/hashicorp/terraform-plugin-framework/function"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &verifyUserTokenFunction{}
+
+// NewVerifyUserTokenFunction is a helper function to simplify the provider implementation.
+func NewVerifyUserTokenFunction() function.Function {
+    return &verifyUserTokenFunction{}
+}
+
+// verifyUserTokenFunction is the function implementation.
+type verifyUserTokenFunction struct{}
+
+// Metadata returns the function name.
+func (f *verifyUserTokenFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+    resp.Name = "verify_user_token"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *verifyUserTokenFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+    resp.Definition = function.Definition{
+        Summary: "Verifies a Paragon Connect user token and returns its claims.",
+        MarkdownDescription: "Verifies the RS256 signature of a Paragon Connect user token with the public key of an " +
+            "SDK key and returns its claims as an object, e.g. `sub` for the user and `aud` for the project. Functions " +
+            "must return the same result when planning and applying, so the expiry is not checked: compare `exp` with " +
+            "the time of your choice instead.",
+        Parameters: []function.Parameter{
+            function.StringParameter{
+                Name:                "public_key",
+                MarkdownDescription: "PEM encoded RSA public key of the SDK key. A private key is accepted as well.",
+            },
+            function.StringParameter{
+                Name:                "token",
+                MarkdownDescription: "The user token to verify.",
+            },
+        },
+        Return: function.DynamicReturn{},
+    }
+}
+
+// Run verifies the token.
+func (f *verifyUserTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+    var publicKeyPEM, token string
+
+    resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &publicKeyPEM, &token))
+    if resp.Error != nil {
+        return
+    }
+
+    publicKey, err := parseRSAPublicKey(publicKeyPEM)
+    if err != nil {
+        resp.Error = function.NewArgumentFuncError(0, "Invalid public key: "+err.Error())
+        return
+    }
+
+    claims, err := verifyUserToken(publicKey, token)
+    if err != nil {
+        resp.Error = function.NewArgumentFuncError(1, "Invalid user token: "+err.Error())
+        return
+    }
+
+    value, err := jsonToValue(claims)
+    if err != nil {
+        resp.Error = function.NewFuncError("Could not convert the token claims: " + err.Error())
+        return
+    }
+
+    resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(value)))
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkflowDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-workflow")
+	integration := server.AddIntegration(project.ID, "salesforce")
+	workflow := server.AddWorkflow(project.ID, integration.ID, "Sync contacts")
+
+	config := func(description string) string {
+		return testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_workflow" "test" {
+  project_id     = %[1]q
+  integration_id = %[2]q
+  description    = %[3]q
+}
+`, project.ID, integration.ID, description)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Sync contacts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_workflow.test", "id", workflow.ID),
+					resource.TestCheckResourceAttr("data.paragon_workflow.test", "workflow_version", "1"),
+				),
+			},
+			{
+				Config:      config("missing"),
+				ExpectError: regexp.MustCompile(`Workflow with description 'missing' not found`),
+			},
+		},
+	})
+}
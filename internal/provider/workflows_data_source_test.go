@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkflowsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	project := testAccProject(t, server, "acc-workflows")
+	integration := server.AddIntegration(project.ID, "salesforce")
+	server.AddWorkflow(project.ID, integration.ID, "Sync contacts")
+	server.AddWorkflow(project.ID, integration.ID, "Sync leads")
+	other := server.AddIntegration(project.ID, "slack")
+	server.AddWorkflow(project.ID, other.ID, "Notify")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "paragon_workflows" "test" {
+  project_id     = %[1]q
+  integration_id = %[2]q
+}
+`, project.ID, integration.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paragon_workflows.test", "workflows.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.paragon_workflows.test", "workflows.*", map[string]string{
+						"description":    "Sync leads",
+						"integration_id": integration.ID,
+					}),
+				),
+			},
+		},
+	})
+}